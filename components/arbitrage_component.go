@@ -0,0 +1,44 @@
+package components
+
+import (
+	"QuoteService/proto"
+
+	logger "github.com/sirupsen/logrus"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var (
+	arbitrageOpportunityEventRkName = "rk.ArbitrageOpportunityEvent"
+
+	arbitrageOpportunityEventMarshalErrMsg = "Error while marshal ArbitrageOpportunityEvent: %s"
+	arbitrageProcessingErr                 = "Error while processing arbitrage: %s"
+
+	publishedArbitrageOpportunityEventMsg = "QuoteService published ArbitrageOpportunityEvent: %+v"
+)
+
+// sendArbitrageOpportunityEvents checks the round trips through the pair after its best bid or ask moved
+// and publishes ArbitrageOpportunityEvent for every profitable one.
+func (q *QuoteComponent) sendArbitrageOpportunityEvents(pair string) {
+	arbitrageOpportunityEvents, err := q.Processing.GetArbitrageOpportunityEvents(pair)
+	if err != nil {
+		logger.Errorf(arbitrageProcessingErr, err.Error())
+		return
+	}
+
+	for _, arbitrageOpportunityEvent := range arbitrageOpportunityEvents {
+		q.sendArbitrageOpportunityEvent(arbitrageOpportunityEvent)
+		logger.Infof(publishedArbitrageOpportunityEventMsg, arbitrageOpportunityEvent.String())
+	}
+}
+
+func (q *QuoteComponent) sendArbitrageOpportunityEvent(arbitrageOpportunityEvent *proto.ArbitrageOpportunityEvent) {
+	sendBody, err := googleProto.Marshal(arbitrageOpportunityEvent)
+	if err != nil {
+		logger.Errorf(arbitrageOpportunityEventMarshalErrMsg, err.Error())
+		return
+	}
+
+	if err := q.RabbitProvider.SendMessage(quoteServiceExchangeName, arbitrageOpportunityEventRkName, sendBody); err != nil {
+		logger.Errorf(sendMessageErrMsg, arbitrageOpportunityEventRkName, err.Error())
+	}
+}
@@ -0,0 +1,58 @@
+package components
+
+import (
+	"QuoteService/proto"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var (
+	unmarshalGetAveragePricesRequestErrMsg = "Error while unmarshal GetAveragePricesRequest: %s"
+	getAveragePricesResponseMarshalErrMsg  = "Error while marshal GetAveragePricesResponse: %s"
+	averagePriceProcessingErr              = "Error while processing average prices: %s"
+
+	gotGetAveragePricesRequestMsg = "QuoteService got GetAveragePricesRequest: %s"
+)
+
+// GetAveragePrices replies to GetAveragePricesRequest with VWAP and TWAP of the pair over every configured window.
+func (q *QuoteComponent) GetAveragePrices(byteGetAveragePricesRequest []byte) []byte {
+	var getAveragePricesRequest proto.GetAveragePricesRequest
+	if err := googleProto.Unmarshal(byteGetAveragePricesRequest, &getAveragePricesRequest); err != nil {
+		logger.Errorf(unmarshalGetAveragePricesRequestErrMsg, err.Error())
+		return q.marshalGetAveragePricesResponse(&proto.GetAveragePricesResponse{
+			Error: &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()},
+		})
+	}
+
+	logger.Infof(gotGetAveragePricesRequestMsg, getAveragePricesRequest.String())
+
+	getAveragePricesResponse := &proto.GetAveragePricesResponse{Pair: getAveragePricesRequest.Pair}
+	averagePrices, err := q.Processing.GetAveragePrices(q.Processing.Instruments.Name(getAveragePricesRequest.Pair), time.Now())
+	if err != nil {
+		logger.Errorf(averagePriceProcessingErr, err.Error())
+		getAveragePricesResponse.Error = newErrorDto(err)
+		return q.marshalGetAveragePricesResponse(getAveragePricesResponse)
+	}
+
+	getAveragePricesResponse.AveragePrices = averagePrices
+	return q.marshalGetAveragePricesResponse(getAveragePricesResponse)
+}
+
+func (q *QuoteComponent) marshalGetAveragePricesResponse(getAveragePricesResponse *proto.GetAveragePricesResponse) []byte {
+	sendBody, err := googleProto.Marshal(getAveragePricesResponse)
+	if err != nil {
+		logger.Errorf(getAveragePricesResponseMarshalErrMsg, err.Error())
+		return nil
+	}
+
+	return sendBody
+}
+
+func (q *QuoteComponent) updateAveragePrices(matchOrdersEvent *proto.MatchOrdersEvent, tradeTime time.Time) {
+	matchedOrder := matchOrdersEvent.LimitMatchedOrder
+	if err := q.Processing.UpdateAveragePrices(getMatchId(matchOrdersEvent), &matchedOrder.Pair, matchedOrder.InitPrice, matchOrdersEvent.MatchedVolume, tradeTime); err != nil {
+		logger.Errorf(averagePriceProcessingErr, err.Error())
+	}
+}
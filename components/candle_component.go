@@ -0,0 +1,96 @@
+package components
+
+import (
+	"QuoteService/proto"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var (
+	pairCandleEventRkName = "rk.CandleEvent.%s.%s"
+
+	candleEventMarshalErrMsg         = "Error while marshal CandleEvent: %s"
+	unmarshalGetCandlesRequestErrMsg = "Error while unmarshal GetCandlesRequest: %s"
+	getCandlesResponseMarshalErrMsg  = "Error while marshal GetCandlesResponse: %s"
+	candleProcessingErr              = "Error while processing candles: %s"
+
+	publishedCandleEventMsg = "QuoteService published CandleEvent: %+v"
+	gotGetCandlesRequestMsg = "QuoteService got GetCandlesRequest: %s"
+)
+
+// SendClosedCandleEventsBySchedule publishes CandleEvent for the candles that closed since the previous run.
+func (q *QuoteComponent) SendClosedCandleEventsBySchedule(sendCandleEventsScheduleTime time.Duration) {
+	for {
+		time.Sleep(sendCandleEventsScheduleTime)
+
+		candleEvents, err := q.Processing.GetClosedCandleEvents(time.Now())
+		if err != nil {
+			logger.Errorf(candleProcessingErr, err.Error())
+			continue
+		}
+
+		for _, candleEvent := range candleEvents {
+			q.sendCandleEvent(candleEvent)
+			logger.Infof(publishedCandleEventMsg, candleEvent.String())
+		}
+	}
+}
+
+// GetCandles replies to GetCandlesRequest with a page of the stored candles of the pair and interval.
+func (q *QuoteComponent) GetCandles(byteGetCandlesRequest []byte) []byte {
+	var getCandlesRequest proto.GetCandlesRequest
+	if err := googleProto.Unmarshal(byteGetCandlesRequest, &getCandlesRequest); err != nil {
+		logger.Errorf(unmarshalGetCandlesRequestErrMsg, err.Error())
+		return q.marshalGetCandlesResponse(&proto.GetCandlesResponse{
+			Error: &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()},
+		})
+	}
+
+	logger.Infof(gotGetCandlesRequestMsg, getCandlesRequest.String())
+
+	getCandlesResponse := &proto.GetCandlesResponse{Pair: getCandlesRequest.Pair, Interval: getCandlesRequest.Interval}
+	candles, nextFromTime, err := q.Processing.GetCandles(q.Processing.Instruments.Name(getCandlesRequest.Pair), getCandlesRequest.Interval,
+		getCandlesRequest.FromTime, getCandlesRequest.ToTime, int(getCandlesRequest.Limit))
+	if err != nil {
+		logger.Errorf(candleProcessingErr, err.Error())
+		getCandlesResponse.Error = newErrorDto(err)
+		return q.marshalGetCandlesResponse(getCandlesResponse)
+	}
+
+	getCandlesResponse.Candles, getCandlesResponse.NextFromTime = candles, nextFromTime
+	return q.marshalGetCandlesResponse(getCandlesResponse)
+}
+
+func (q *QuoteComponent) marshalGetCandlesResponse(getCandlesResponse *proto.GetCandlesResponse) []byte {
+	sendBody, err := googleProto.Marshal(getCandlesResponse)
+	if err != nil {
+		logger.Errorf(getCandlesResponseMarshalErrMsg, err.Error())
+		return nil
+	}
+
+	return sendBody
+}
+
+func (q *QuoteComponent) updateCandles(matchOrdersEvent *proto.MatchOrdersEvent, tradeTime time.Time) {
+	matchedOrder := matchOrdersEvent.LimitMatchedOrder
+	if err := q.Processing.UpdateCandles(getMatchId(matchOrdersEvent), &matchedOrder.Pair, matchedOrder.InitPrice, matchOrdersEvent.MatchedVolume, tradeTime); err != nil {
+		logger.Errorf(candleProcessingErr, err.Error())
+	}
+}
+
+func (q *QuoteComponent) sendCandleEvent(candleEvent *proto.CandleEvent) {
+	sendBody, err := googleProto.Marshal(candleEvent)
+	if err != nil {
+		logger.Errorf(candleEventMarshalErrMsg, err.Error())
+		return
+	}
+
+	rk := fmt.Sprintf(pairCandleEventRkName, q.Processing.Instruments.Name(candleEvent.Pair), candleEvent.Interval.String())
+	// Closed candles are claimed before they are published and never claimed again, so they go through the outbox.
+	if err := q.RabbitProvider.SendReliableMessage(quoteServiceExchangeName, rk, sendBody); err != nil {
+		logger.Errorf(sendMessageErrMsg, rk, err.Error())
+	}
+}
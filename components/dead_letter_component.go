@@ -0,0 +1,104 @@
+package components
+
+import (
+	"QuoteService/proto"
+	"QuoteService/providers"
+	"errors"
+
+	logger "github.com/sirupsen/logrus"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var (
+	unmarshalGetDeadLettersRequestErrMsg    = "Error while unmarshal GetDeadLettersRequest: %s"
+	getDeadLettersResponseMarshalErrMsg     = "Error while marshal GetDeadLettersResponse: %s"
+	unmarshalReplayDeadLettersRequestErrMsg = "Error while unmarshal ReplayDeadLettersRequest: %s"
+	replayDeadLettersResponseMarshalErrMsg  = "Error while marshal ReplayDeadLettersResponse: %s"
+	deadLetterProcessingErr                 = "Error while processing dead letters: %s"
+
+	gotGetDeadLettersRequestMsg    = "QuoteService got GetDeadLettersRequest: %s"
+	gotReplayDeadLettersRequestMsg = "QuoteService got ReplayDeadLettersRequest: %s"
+)
+
+// GetDeadLetters replies to GetDeadLettersRequest with the messages in the dead letter queue of a listener queue.
+func (q *QuoteComponent) GetDeadLetters(byteGetDeadLettersRequest []byte) []byte {
+	var getDeadLettersRequest proto.GetDeadLettersRequest
+	if err := googleProto.Unmarshal(byteGetDeadLettersRequest, &getDeadLettersRequest); err != nil {
+		logger.Errorf(unmarshalGetDeadLettersRequestErrMsg, err.Error())
+		return q.marshalGetDeadLettersResponse(&proto.GetDeadLettersResponse{
+			Error: &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()},
+		})
+	}
+
+	logger.Infof(gotGetDeadLettersRequestMsg, getDeadLettersRequest.String())
+
+	getDeadLettersResponse := &proto.GetDeadLettersResponse{Queue: getDeadLettersRequest.Queue}
+	deadLetters, err := q.RabbitProvider.GetDeadLetters(getDeadLettersRequest.Queue, int(getDeadLettersRequest.Limit))
+	if err != nil {
+		logger.Errorf(deadLetterProcessingErr, err.Error())
+		getDeadLettersResponse.Error = newDeadLetterErrorDto(err)
+		return q.marshalGetDeadLettersResponse(getDeadLettersResponse)
+	}
+
+	for _, deadLetter := range deadLetters {
+		getDeadLettersResponse.DeadLetters = append(getDeadLettersResponse.DeadLetters, &proto.DeadLetter{
+			Exchange:   deadLetter.Exchange,
+			RoutingKey: deadLetter.RoutingKey,
+			Reason:     deadLetter.Reason,
+			Retries:    uint32(deadLetter.Retries),
+			Body:       deadLetter.Body,
+		})
+	}
+
+	return q.marshalGetDeadLettersResponse(getDeadLettersResponse)
+}
+
+// ReplayDeadLetters replies to ReplayDeadLettersRequest after moving messages from the dead letter queue back to the listener queue.
+func (q *QuoteComponent) ReplayDeadLetters(byteReplayDeadLettersRequest []byte) []byte {
+	var replayDeadLettersRequest proto.ReplayDeadLettersRequest
+	if err := googleProto.Unmarshal(byteReplayDeadLettersRequest, &replayDeadLettersRequest); err != nil {
+		logger.Errorf(unmarshalReplayDeadLettersRequestErrMsg, err.Error())
+		return q.marshalReplayDeadLettersResponse(&proto.ReplayDeadLettersResponse{
+			Error: &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()},
+		})
+	}
+
+	logger.Infof(gotReplayDeadLettersRequestMsg, replayDeadLettersRequest.String())
+
+	replayed, err := q.RabbitProvider.ReplayDeadLetters(replayDeadLettersRequest.Queue, int(replayDeadLettersRequest.Limit))
+	replayDeadLettersResponse := &proto.ReplayDeadLettersResponse{Queue: replayDeadLettersRequest.Queue, Replayed: uint32(replayed)}
+	if err != nil {
+		logger.Errorf(deadLetterProcessingErr, err.Error())
+		replayDeadLettersResponse.Error = newDeadLetterErrorDto(err)
+	}
+
+	return q.marshalReplayDeadLettersResponse(replayDeadLettersResponse)
+}
+
+// newDeadLetterErrorDto answers an unknown queue with ERROR_INVALID_REQUEST and any rabbit error with ERROR_INTERNAL.
+func newDeadLetterErrorDto(err error) *proto.ErrorDto {
+	if errors.Is(err, providers.ErrUnknownDeadLetterQueue) {
+		return &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()}
+	}
+	return &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INTERNAL, Message: err.Error()}
+}
+
+func (q *QuoteComponent) marshalGetDeadLettersResponse(getDeadLettersResponse *proto.GetDeadLettersResponse) []byte {
+	sendBody, err := googleProto.Marshal(getDeadLettersResponse)
+	if err != nil {
+		logger.Errorf(getDeadLettersResponseMarshalErrMsg, err.Error())
+		return nil
+	}
+
+	return sendBody
+}
+
+func (q *QuoteComponent) marshalReplayDeadLettersResponse(replayDeadLettersResponse *proto.ReplayDeadLettersResponse) []byte {
+	sendBody, err := googleProto.Marshal(replayDeadLettersResponse)
+	if err != nil {
+		logger.Errorf(replayDeadLettersResponseMarshalErrMsg, err.Error())
+		return nil
+	}
+
+	return sendBody
+}
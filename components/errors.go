@@ -0,0 +1,25 @@
+package components
+
+import (
+	"QuoteService/processing"
+	"QuoteService/proto"
+	"QuoteService/providers"
+	"errors"
+)
+
+// newErrorDto answers errors caused by the request with ERROR_INVALID_REQUEST and any other processing error with ERROR_REDIS_PROCESSING.
+func newErrorDto(err error) *proto.ErrorDto {
+	if errors.Is(err, processing.ErrInvalidRequest) {
+		return &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()}
+	}
+	return &proto.ErrorDto{Code: proto.ErrorCode_ERROR_REDIS_PROCESSING, Message: err.Error()}
+}
+
+// newListenerError marks processing errors caused by the message itself, such as a volume that is not a whole
+// number of volume steps, as poison, so listeners dead letter the message instead of retrying it.
+func newListenerError(err error) error {
+	if errors.Is(err, processing.ErrInvalidRequest) {
+		return providers.PoisonMessageError("%s", err.Error())
+	}
+	return err
+}
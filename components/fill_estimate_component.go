@@ -0,0 +1,51 @@
+package components
+
+import (
+	"QuoteService/proto"
+
+	logger "github.com/sirupsen/logrus"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var (
+	unmarshalEstimateFillRequestErrMsg = "Error while unmarshal EstimateFillRequest: %s"
+	estimateFillResponseMarshalErrMsg  = "Error while marshal EstimateFillResponse: %s"
+	fillEstimateProcessingErr          = "Error while processing fill estimate: %s"
+
+	gotEstimateFillRequestMsg = "QuoteService got EstimateFillRequest: %s"
+)
+
+// EstimateFill replies to EstimateFillRequest with the expected fill of a MARKET order against the current book.
+func (q *QuoteComponent) EstimateFill(byteEstimateFillRequest []byte) []byte {
+	var estimateFillRequest proto.EstimateFillRequest
+	if err := googleProto.Unmarshal(byteEstimateFillRequest, &estimateFillRequest); err != nil {
+		logger.Errorf(unmarshalEstimateFillRequestErrMsg, err.Error())
+		return q.marshalEstimateFillResponse(&proto.EstimateFillResponse{
+			Error: &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()},
+		})
+	}
+
+	logger.Infof(gotEstimateFillRequestMsg, estimateFillRequest.String())
+
+	estimateFillResponse, err := q.Processing.EstimateFill(q.Processing.Instruments.Name(estimateFillRequest.Pair), estimateFillRequest.Direction, estimateFillRequest.Volume)
+	if err != nil {
+		logger.Errorf(fillEstimateProcessingErr, err.Error())
+		return q.marshalEstimateFillResponse(&proto.EstimateFillResponse{
+			Pair:      estimateFillRequest.Pair,
+			Direction: estimateFillRequest.Direction,
+			Error:     newErrorDto(err),
+		})
+	}
+
+	return q.marshalEstimateFillResponse(estimateFillResponse)
+}
+
+func (q *QuoteComponent) marshalEstimateFillResponse(estimateFillResponse *proto.EstimateFillResponse) []byte {
+	sendBody, err := googleProto.Marshal(estimateFillResponse)
+	if err != nil {
+		logger.Errorf(estimateFillResponseMarshalErrMsg, err.Error())
+		return nil
+	}
+
+	return sendBody
+}
@@ -0,0 +1,92 @@
+package components
+
+import (
+	"QuoteService/proto"
+	"fmt"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	logger "github.com/sirupsen/logrus"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var (
+	pairLiquidityMetricsEventRkName = "rk.LiquidityMetricsEvent.%s"
+
+	liquidityMetricsEventMarshalErrMsg = "Error while marshal LiquidityMetricsEvent: %s"
+	liquidityProcessingErr             = "Error while processing liquidity metrics: %s"
+
+	publishedLiquidityMetricsEventMsg = "QuoteService published LiquidityMetricsEvent: %+v"
+
+	bidSideLabel = "bid"
+	askSideLabel = "ask"
+)
+
+var (
+	bookLevelsGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "quote_service_book_levels",
+		Help: "Number of price levels on a side of the book.",
+	}, []string{"pair", "side"})
+	bookVolumeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "quote_service_book_volume",
+		Help: "Total volume on a side of the book.",
+	}, []string{"pair", "side"})
+	bookSpreadTicksGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "quote_service_book_spread_ticks",
+		Help: "Spread between the best ask and bid in price ticks, 0 when a side is empty.",
+	}, []string{"pair"})
+	bookMidPriceGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "quote_service_book_mid_price",
+		Help: "Mid price between the best bid and ask, 0 when a side is empty.",
+	}, []string{"pair"})
+	bookDepthVolumeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "quote_service_book_depth_volume",
+		Help: "Volume on a side of the book within percent of the mid price.",
+	}, []string{"pair", "side", "percent"})
+	bookImbalanceGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "quote_service_book_imbalance",
+		Help: "(bid - ask) / (bid + ask) volume within percent of the mid price.",
+	}, []string{"pair", "percent"})
+)
+
+// sendLiquidityMetricsEvent publishes the liquidity metrics of the pair after a book update and exports them as gauges.
+func (q *QuoteComponent) sendLiquidityMetricsEvent(pair proto.OrderPair) {
+	stringPair := q.Processing.Instruments.Name(pair)
+	liquidityMetricsEvent, err := q.Processing.GetLiquidityMetricsEvent(stringPair)
+	if err != nil {
+		logger.Errorf(liquidityProcessingErr, err.Error())
+		return
+	}
+
+	setLiquidityGauges(stringPair, liquidityMetricsEvent)
+
+	sendBody, err := googleProto.Marshal(liquidityMetricsEvent)
+	if err != nil {
+		logger.Errorf(liquidityMetricsEventMarshalErrMsg, err.Error())
+		return
+	}
+
+	rk := fmt.Sprintf(pairLiquidityMetricsEventRkName, stringPair)
+	if err := q.RabbitProvider.SendMessage(quoteServiceExchangeName, rk, sendBody); err != nil {
+		logger.Errorf(sendMessageErrMsg, rk, err.Error())
+		return
+	}
+	logger.Debugf(publishedLiquidityMetricsEventMsg, liquidityMetricsEvent.String())
+}
+
+func setLiquidityGauges(pair string, liquidityMetricsEvent *proto.LiquidityMetricsEvent) {
+	bookLevelsGauge.WithLabelValues(pair, bidSideLabel).Set(float64(liquidityMetricsEvent.BidLevels))
+	bookLevelsGauge.WithLabelValues(pair, askSideLabel).Set(float64(liquidityMetricsEvent.AskLevels))
+	bookVolumeGauge.WithLabelValues(pair, bidSideLabel).Set(liquidityMetricsEvent.BidVolume)
+	bookVolumeGauge.WithLabelValues(pair, askSideLabel).Set(liquidityMetricsEvent.AskVolume)
+	bookSpreadTicksGauge.WithLabelValues(pair).Set(float64(liquidityMetricsEvent.SpreadTicks))
+	bookMidPriceGauge.WithLabelValues(pair).Set(liquidityMetricsEvent.MidPrice)
+
+	for _, depthBand := range liquidityMetricsEvent.DepthBands {
+		percent := strconv.FormatFloat(depthBand.Percent, 'f', -1, 64)
+		bookDepthVolumeGauge.WithLabelValues(pair, bidSideLabel, percent).Set(depthBand.BidVolume)
+		bookDepthVolumeGauge.WithLabelValues(pair, askSideLabel, percent).Set(depthBand.AskVolume)
+		bookImbalanceGauge.WithLabelValues(pair, percent).Set(depthBand.Imbalance)
+	}
+}
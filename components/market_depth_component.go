@@ -4,6 +4,7 @@ import (
 	"QuoteService/processing"
 	"QuoteService/proto"
 	"QuoteService/providers"
+	"fmt"
 	"time"
 
 	logger "github.com/sirupsen/logrus"
@@ -13,11 +14,16 @@ import (
 type QuoteComponent struct {
 	RabbitProvider *providers.RabbitProvider
 	Processing     *processing.QuoteProcessing
+
+	// PublishAllPairsMarketDepthEvent additionally publishes the book of every pair on rk.MarketDepthEvent.
+	PublishAllPairsMarketDepthEvent bool
 }
 
 var (
-	quoteServiceExchangeName = "ex.QuoteService"
-	marketDepthEventRkName   = "rk.MarketDepthEvent"
+	quoteServiceExchangeName   = "ex.QuoteService"
+	marketDepthEventRkName     = "rk.MarketDepthEvent"
+	pairMarketDepthEventRkName = "rk.MarketDepthEvent.%s"
+	marketDepthDiffEventRkName = "rk.MarketDepthDiffEvent"
 
 	gotMCreateOrderResponseMsg   = "QuoteService got CreateOrderResponse: %s\n"
 	gotErrCreateOrderResponseMsg = "QuoteService got CreateOrderResponse with market order or with err: %s. Skipping\n"
@@ -25,112 +31,199 @@ var (
 	gotErrRemoveOrderResponseMsg = "QuoteService got RemoveOrderResponse with err: %s. Skipping\n"
 	gotMatchOrdersEventMsg       = "QuoteService got MatchOrdersEvent: %s\n"
 	gotErrMatchOrdersEventMsg    = "QuoteService got MatchOrdersEvent with err: %s. Skipping\n"
+	alreadyAppliedUpdateMsg      = "QuoteService already applied market depth update: %s, skipping MarketDepthDiffEvent"
+	filledOrderRemovedMsg        = "QuoteService got removed order: %s without volume left in the book, skipping"
 
-	noMarketDepthMsg = "No Market Depth, skipping send schedule MarketDepthEvent"
+	noMarketDepthMsg     = "No Market Depth, skipping send schedule MarketDepthEvent"
+	noPairMarketDepthMsg = "No Market Depth for pair: %s, skipping send schedule MarketDepthEvent"
 
-	unmarshalCreateOrderResponseErrMsg = "Error while unmarshal CreateOrderResponse"
-	unmarshalRemoveOrderResponseErrMsg = "Error while unmarshal RemoveOrderResponse"
-	unmarshalMatchOrdersEventErrMsg    = "Error while unmarshal MatchOrdersEvent"
-	marketDepthEventMarshalErrMsg      = "Error while marshal MarketDepthEvent"
-	marketDepthProcessingErr           = "Error while processing update marketDepth: %s"
+	unmarshalCreateOrderResponseErrMsg   = "Error while unmarshal CreateOrderResponse: %s"
+	unmarshalRemoveOrderResponseErrMsg   = "Error while unmarshal RemoveOrderResponse: %s"
+	unmarshalMatchOrdersEventErrMsg      = "Error while unmarshal MatchOrdersEvent: %s"
+	noCreatedOrderErrMsg                 = "CreateOrderResponse without created order: %s"
+	noRemovedOrderErrMsg                 = "RemoveOrderResponse without removed order: %s"
+	noMatchedOrdersErrMsg                = "MatchOrdersEvent without matched orders: %s"
+	noOrderIdErrMsg                      = "%s without order id: %s"
+	unmarshalGetMarketDepthRequestErrMsg = "Error while unmarshal GetMarketDepthRequest: %s"
+	getMarketDepthResponseMarshalErrMsg  = "Error while marshal GetMarketDepthResponse: %s"
+	unmarshalSnapshotRequestErrMsg       = "Error while unmarshal MarketDepthSnapshotRequest: %s"
+	snapshotResponseMarshalErrMsg        = "Error while marshal MarketDepthSnapshotResponse: %s"
+	marketDepthEventMarshalErrMsg        = "Error while marshal MarketDepthEvent"
+	sendMessageErrMsg                    = "Error while send message with rk: %s: %s"
+	marketDepthDiffEventMarshalErrMsg    = "Error while marshal MarketDepthDiffEvent: %s"
+	marketDepthProcessingErr             = "Error while processing update marketDepth: %s"
 
 	publishedMarketDepthEventMsg         = "QuoteService published MarketDepthEvent: %+v"
+	publishedMarketDepthDiffEventMsg     = "QuoteService published MarketDepthDiffEvent: %+v"
 	publishedScheduleMarketDepthEventMsg = "QuoteService published schedule MarketDepthEvent: %+v"
+	gotGetMarketDepthRequestMsg          = "QuoteService got GetMarketDepthRequest"
+	gotSnapshotRequestMsg                = "QuoteService got MarketDepthSnapshotRequest: %s"
 )
 
-func (q *QuoteComponent) UpdateMarketDepthByCreateOrderResponse(byteCreateOrderRresponse []byte) {
+func (q *QuoteComponent) UpdateMarketDepthByCreateOrderResponse(byteCreateOrderRresponse []byte) error {
 	var createOrderResponse proto.CreateOrderResponse
 	if err := googleProto.Unmarshal(byteCreateOrderRresponse, &createOrderResponse); err != nil {
-		logger.Error(unmarshalCreateOrderResponseErrMsg)
-		return
+		return providers.PoisonMessageError(unmarshalCreateOrderResponseErrMsg, err.Error())
 	}
 
-	if createOrderResponse.CreatedOrder.Type == proto.OrderType_MARKET || createOrderResponse.Error != nil {
+	if createOrderResponse.Error == nil && createOrderResponse.CreatedOrder == nil {
+		return providers.PoisonMessageError(noCreatedOrderErrMsg, createOrderResponse.String())
+	}
+	if createOrderResponse.Error == nil && createOrderResponse.CreatedOrder.OrderId == "" {
+		return providers.PoisonMessageError(noOrderIdErrMsg, "CreateOrderResponse", createOrderResponse.String())
+	}
+
+	if createOrderResponse.Error != nil || createOrderResponse.CreatedOrder.Type == proto.OrderType_MARKET {
 		logger.Debugf(gotErrCreateOrderResponseMsg, createOrderResponse.String())
-		return
+		return nil
 	}
 
 	logger.Infof(gotMCreateOrderResponseMsg, createOrderResponse.String())
 
 	createdOrder := createOrderResponse.CreatedOrder
-	marketDepthEvent, err := q.Processing.UpdateMarketDepth(createdOrder.Direction.String(), createdOrder.Pair.String(), createdOrder.InitPrice, createdOrder.InitVolume)
+	updateId := "created:" + createdOrder.OrderId
+	marketDepthDiffEvent, applied, err := q.Processing.UpdateMarketDepth(updateId, createdOrder.Direction.String(), q.Processing.Instruments.Name(createdOrder.Pair), createdOrder.InitPrice, createdOrder.InitVolume)
 	if err != nil {
 		logger.Errorf(marketDepthProcessingErr, err.Error())
-		return
+		return newListenerError(err)
 	}
 
-	q.sendMarketDepthEventEvent(marketDepthEvent)
-	logger.Infof(publishedMarketDepthEventMsg, marketDepthEvent.String())
+	q.sendMarketDepthDiffEventIfApplied(updateId, marketDepthDiffEvent, applied)
+	q.sendCurrentMarketDepthEvent(marketDepthDiffEvent.Pair)
+	q.sendTopOfBookEventIfChanged(marketDepthDiffEvent.Pair)
+	q.sendLiquidityMetricsEvent(marketDepthDiffEvent.Pair)
+	return nil
 }
 
-func (q *QuoteComponent) UpdateMarketDepthByRemoveOrderResponse(byteRemoveOrderResponse []byte) {
+func (q *QuoteComponent) UpdateMarketDepthByRemoveOrderResponse(byteRemoveOrderResponse []byte) error {
 	var removeOrderResponse proto.RemoveOrderResponse
 	if err := googleProto.Unmarshal(byteRemoveOrderResponse, &removeOrderResponse); err != nil {
-		logger.Error(unmarshalRemoveOrderResponseErrMsg)
-		return
+		return providers.PoisonMessageError(unmarshalRemoveOrderResponseErrMsg, err.Error())
 	}
 
 	if removeOrderResponse.Error != nil {
 		logger.Debugf(gotErrRemoveOrderResponseMsg, removeOrderResponse.String())
-		return
+		return nil
+	}
+
+	if removeOrderResponse.RemovedOrder == nil {
+		return providers.PoisonMessageError(noRemovedOrderErrMsg, removeOrderResponse.String())
+	}
+	if removeOrderResponse.RemovedOrder.OrderId == "" {
+		return providers.PoisonMessageError(noOrderIdErrMsg, "RemoveOrderResponse", removeOrderResponse.String())
 	}
 
 	logger.Infof(gotRemoveOrderResponseMsg, removeOrderResponse.String())
 
 	removedOrder := removeOrderResponse.RemovedOrder
-	updateVolume := -(removedOrder.InitVolume - removedOrder.FilledVolume)
-	marketDepthEvent, err := q.Processing.UpdateMarketDepth(removedOrder.Direction.String(), removedOrder.Pair.String(), removedOrder.InitPrice, updateVolume)
+	updateId := "removed:" + removedOrder.OrderId
+	marketDepthDiffEvent, applied, err := q.Processing.RemoveOrderFromMarketDepth(updateId, removedOrder.Direction.String(),
+		q.Processing.Instruments.Name(removedOrder.Pair), removedOrder.InitPrice, removedOrder.InitVolume, removedOrder.FilledVolume)
 	if err != nil {
 		logger.Errorf(marketDepthProcessingErr, err.Error())
-		return
+		return newListenerError(err)
+	}
+	if marketDepthDiffEvent == nil {
+		logger.Infof(filledOrderRemovedMsg, removedOrder.OrderId)
+		return nil
 	}
 
-	q.sendMarketDepthEventEvent(marketDepthEvent)
-	logger.Infof(publishedMarketDepthEventMsg, marketDepthEvent.String())
+	q.sendMarketDepthDiffEventIfApplied(updateId, marketDepthDiffEvent, applied)
+	q.sendCurrentMarketDepthEvent(marketDepthDiffEvent.Pair)
+	q.sendTopOfBookEventIfChanged(marketDepthDiffEvent.Pair)
+	q.sendLiquidityMetricsEvent(marketDepthDiffEvent.Pair)
+	return nil
 }
 
-func (q *QuoteComponent) UpdateMarketDepthByMatchOrdersEvent(byteMatchOrdersEvent []byte) {
-	var matchOrdersEvent proto.MatchOrdersEvent
-	if err := googleProto.Unmarshal(byteMatchOrdersEvent, &matchOrdersEvent); err != nil {
-		logger.Error(unmarshalMatchOrdersEventErrMsg)
-		return
+func (q *QuoteComponent) UpdateMarketDepthByMatchOrdersEvent(byteMatchOrdersEvent []byte) error {
+	matchOrdersEvent, err := unmarshalMatchOrdersEvent(byteMatchOrdersEvent)
+	if err != nil {
+		return err
 	}
 
 	if matchOrdersEvent.Error != nil {
 		logger.Debugf(gotErrMatchOrdersEventMsg, matchOrdersEvent.String())
-		return
+		return nil
 	}
 
 	logger.Infof(gotMatchOrdersEventMsg, matchOrdersEvent.String())
 
-	limitOrders := []proto.Order{*matchOrdersEvent.LimitMatchedOrder}
+	limitOrders := []*proto.Order{matchOrdersEvent.LimitMatchedOrder}
 	if matchOrdersEvent.CreatedMatchedOrder.Type == proto.OrderType_LIMIT {
-		limitOrders = append(limitOrders, *matchOrdersEvent.CreatedMatchedOrder)
+		limitOrders = append(limitOrders, matchOrdersEvent.CreatedMatchedOrder)
 	}
 
-	var marketDepthEvent *proto.MarketDepthEvent
-	var err error
+	// Every level is updated once per match, so when the second update fails a retry applies only that one.
+	matchId := getMatchId(matchOrdersEvent)
 	for _, limitOrder := range limitOrders {
-		marketDepthEvent, err = q.Processing.UpdateMarketDepth(
-			limitOrder.Direction.String(), limitOrder.Pair.String(), limitOrder.InitPrice, -matchOrdersEvent.MatchedVolume)
+		updateId := "matched:" + matchId + ":" + limitOrder.OrderId
+		marketDepthDiffEvent, applied, err := q.Processing.UpdateMarketDepth(
+			updateId, limitOrder.Direction.String(), q.Processing.Instruments.Name(limitOrder.Pair), limitOrder.InitPrice, -matchOrdersEvent.MatchedVolume)
 		if err != nil {
 			logger.Errorf(marketDepthProcessingErr, err.Error())
-			return
+			return newListenerError(err)
 		}
+
+		q.sendMarketDepthDiffEventIfApplied(updateId, marketDepthDiffEvent, applied)
 	}
 
-	q.sendMarketDepthEventEvent(marketDepthEvent)
-	logger.Infof(publishedMarketDepthEventMsg, marketDepthEvent.String())
+	q.sendCurrentMarketDepthEvent(matchOrdersEvent.LimitMatchedOrder.Pair)
+	q.sendTopOfBookEventIfChanged(matchOrdersEvent.LimitMatchedOrder.Pair)
+	q.sendLiquidityMetricsEvent(matchOrdersEvent.LimitMatchedOrder.Pair)
+	return nil
+}
+
+// unmarshalMatchOrdersEvent rejects a MatchOrdersEvent without an error and without both matched orders with their ids as poison.
+func unmarshalMatchOrdersEvent(byteMatchOrdersEvent []byte) (*proto.MatchOrdersEvent, error) {
+	var matchOrdersEvent proto.MatchOrdersEvent
+	if err := googleProto.Unmarshal(byteMatchOrdersEvent, &matchOrdersEvent); err != nil {
+		return nil, providers.PoisonMessageError(unmarshalMatchOrdersEventErrMsg, err.Error())
+	}
+
+	if matchOrdersEvent.Error == nil && (matchOrdersEvent.LimitMatchedOrder == nil || matchOrdersEvent.CreatedMatchedOrder == nil) {
+		return nil, providers.PoisonMessageError(noMatchedOrdersErrMsg, matchOrdersEvent.String())
+	}
+	if matchOrdersEvent.Error == nil && (matchOrdersEvent.LimitMatchedOrder.OrderId == "" || matchOrdersEvent.CreatedMatchedOrder.OrderId == "") {
+		return nil, providers.PoisonMessageError(noOrderIdErrMsg, "MatchOrdersEvent", matchOrdersEvent.String())
+	}
+
+	return &matchOrdersEvent, nil
+}
+
+// getMatchId names a match by its book and aggressor orders, so every redelivery of the event has the same id.
+func getMatchId(matchOrdersEvent *proto.MatchOrdersEvent) string {
+	return matchOrdersEvent.LimitMatchedOrder.OrderId + ":" + matchOrdersEvent.CreatedMatchedOrder.OrderId
 }
 
 func (q *QuoteComponent) SendMarketDepthEventBySchedule(sendMarketDepthEventScheduleTime time.Duration) {
 	for {
 		time.Sleep(sendMarketDepthEventScheduleTime)
 
+		for _, stringPair := range q.Processing.Instruments.Pairs() {
+			pairMarketDepthEvent, err := q.Processing.GetPairMarketDepthEvent(stringPair)
+			if err != nil {
+				// The pair may have been disabled since Pairs was read, the other pairs are published anyway.
+				logger.Errorf(marketDepthProcessingErr, err.Error())
+				continue
+			}
+
+			if pairMarketDepthEvent.MarketDepth == nil {
+				logger.Debugf(noPairMarketDepthMsg, stringPair)
+				continue
+			}
+
+			q.sendMarketDepthEventEvent(pairMarketDepthEvent, fmt.Sprintf(pairMarketDepthEventRkName, stringPair))
+			logger.Infof(publishedScheduleMarketDepthEventMsg, pairMarketDepthEvent.String())
+		}
+
+		if !q.PublishAllPairsMarketDepthEvent {
+			continue
+		}
+
 		marketDepthEvent, err := q.Processing.GetMarketDepthEvent()
 		if err != nil {
 			logger.Errorf(marketDepthProcessingErr, err.Error())
-			return
+			continue
 		}
 
 		if marketDepthEvent.MarketDepth == nil {
@@ -138,17 +231,144 @@ func (q *QuoteComponent) SendMarketDepthEventBySchedule(sendMarketDepthEventSche
 			continue
 		}
 
-		q.sendMarketDepthEventEvent(marketDepthEvent)
+		q.sendMarketDepthEventEvent(marketDepthEvent, marketDepthEventRkName)
 		logger.Infof(publishedScheduleMarketDepthEventMsg, marketDepthEvent.String())
 	}
 }
 
-func (q *QuoteComponent) sendMarketDepthEventEvent(marketDepthEvent *proto.MarketDepthEvent) {
+// GetMarketDepth replies to GetMarketDepthRequest with the full book of every pair,
+// regardless of the truncation applied to published MarketDepthEvent.
+func (q *QuoteComponent) GetMarketDepth(byteGetMarketDepthRequest []byte) []byte {
+	var getMarketDepthResponse proto.GetMarketDepthResponse
+
+	var getMarketDepthRequest proto.GetMarketDepthRequest
+	if err := googleProto.Unmarshal(byteGetMarketDepthRequest, &getMarketDepthRequest); err != nil {
+		logger.Errorf(unmarshalGetMarketDepthRequestErrMsg, err.Error())
+		getMarketDepthResponse.Error = &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()}
+		return q.marshalGetMarketDepthResponse(&getMarketDepthResponse)
+	}
+
+	logger.Info(gotGetMarketDepthRequestMsg)
+
+	marketDepthEvent, err := q.Processing.GetFullMarketDepthEvent()
+	if err != nil {
+		logger.Errorf(marketDepthProcessingErr, err.Error())
+		getMarketDepthResponse.Error = &proto.ErrorDto{Code: proto.ErrorCode_ERROR_REDIS_PROCESSING, Message: err.Error()}
+		return q.marshalGetMarketDepthResponse(&getMarketDepthResponse)
+	}
+
+	getMarketDepthResponse.MarketDepth = marketDepthEvent.MarketDepth
+	return q.marshalGetMarketDepthResponse(&getMarketDepthResponse)
+}
+
+func (q *QuoteComponent) marshalGetMarketDepthResponse(getMarketDepthResponse *proto.GetMarketDepthResponse) []byte {
+	sendBody, err := googleProto.Marshal(getMarketDepthResponse)
+	if err != nil {
+		logger.Errorf(getMarketDepthResponseMarshalErrMsg, err.Error())
+		return nil
+	}
+
+	return sendBody
+}
+
+// GetMarketDepthSnapshot replies to MarketDepthSnapshotRequest with the full book of the pair
+// and the sequence of the last diff it includes, so a consumer that missed diffs can resync.
+func (q *QuoteComponent) GetMarketDepthSnapshot(byteSnapshotRequest []byte) []byte {
+	var snapshotRequest proto.MarketDepthSnapshotRequest
+	if err := googleProto.Unmarshal(byteSnapshotRequest, &snapshotRequest); err != nil {
+		logger.Errorf(unmarshalSnapshotRequestErrMsg, err.Error())
+		return q.marshalSnapshotResponse(&proto.MarketDepthSnapshotResponse{
+			Error: &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()},
+		})
+	}
+
+	logger.Infof(gotSnapshotRequestMsg, snapshotRequest.String())
+
+	snapshotResponse, err := q.Processing.GetMarketDepthSnapshot(q.Processing.Instruments.Name(snapshotRequest.Pair))
+	if err != nil {
+		logger.Errorf(marketDepthProcessingErr, err.Error())
+		return q.marshalSnapshotResponse(&proto.MarketDepthSnapshotResponse{
+			Pair:  snapshotRequest.Pair,
+			Error: newErrorDto(err),
+		})
+	}
+
+	return q.marshalSnapshotResponse(snapshotResponse)
+}
+
+func (q *QuoteComponent) marshalSnapshotResponse(snapshotResponse *proto.MarketDepthSnapshotResponse) []byte {
+	sendBody, err := googleProto.Marshal(snapshotResponse)
+	if err != nil {
+		logger.Errorf(snapshotResponseMarshalErrMsg, err.Error())
+		return nil
+	}
+
+	return sendBody
+}
+
+// sendCurrentMarketDepthEvent publishes the book of the changed pair on its own routing key
+// and, when enabled, the book of every pair on rk.MarketDepthEvent.
+func (q *QuoteComponent) sendCurrentMarketDepthEvent(pair proto.OrderPair) {
+	stringPair := q.Processing.Instruments.Name(pair)
+	pairMarketDepthEvent, err := q.Processing.GetPairMarketDepthEvent(stringPair)
+	if err != nil {
+		logger.Errorf(marketDepthProcessingErr, err.Error())
+		return
+	}
+
+	q.sendMarketDepthEventEvent(pairMarketDepthEvent, fmt.Sprintf(pairMarketDepthEventRkName, stringPair))
+	logger.Infof(publishedMarketDepthEventMsg, pairMarketDepthEvent.String())
+
+	if !q.PublishAllPairsMarketDepthEvent {
+		return
+	}
+
+	marketDepthEvent, err := q.Processing.GetMarketDepthEvent()
+	if err != nil {
+		logger.Errorf(marketDepthProcessingErr, err.Error())
+		return
+	}
+
+	q.sendMarketDepthEventEvent(marketDepthEvent, marketDepthEventRkName)
+	logger.Infof(publishedMarketDepthEventMsg, marketDepthEvent.String())
+}
+
+// sendMarketDepthDiffEventIfApplied publishes the diff of an update applied now. The diff of an update already
+// applied by an earlier delivery of its message is not published again: the sequence it was applied with is behind
+// the book now, and consumers that missed it resync on the gap.
+func (q *QuoteComponent) sendMarketDepthDiffEventIfApplied(updateId string, marketDepthDiffEvent *proto.MarketDepthDiffEvent, applied bool) {
+	if !applied {
+		logger.Infof(alreadyAppliedUpdateMsg, updateId)
+		return
+	}
+
+	q.sendMarketDepthDiffEvent(marketDepthDiffEvent)
+}
+
+func (q *QuoteComponent) sendMarketDepthDiffEvent(marketDepthDiffEvent *proto.MarketDepthDiffEvent) {
+	sendBody, err := googleProto.Marshal(marketDepthDiffEvent)
+	if err != nil {
+		logger.Errorf(marketDepthDiffEventMarshalErrMsg, err.Error())
+		return
+	}
+
+	// Diffs are not kept in the outbox: a lost diff leaves a gap in the pair sequence and consumers
+	// resync from MarketDepthSnapshotRequest on a gap anyway, while resending them would only delay newer ones.
+	if err := q.RabbitProvider.SendMessage(quoteServiceExchangeName, marketDepthDiffEventRkName, sendBody); err != nil {
+		logger.Errorf(sendMessageErrMsg, marketDepthDiffEventRkName, err.Error())
+		return
+	}
+	logger.Infof(publishedMarketDepthDiffEventMsg, marketDepthDiffEvent.String())
+}
+
+func (q *QuoteComponent) sendMarketDepthEventEvent(marketDepthEvent *proto.MarketDepthEvent, rk string) {
 	sendBody, err := googleProto.Marshal(marketDepthEvent)
 	if err != nil {
 		logger.Errorf(marketDepthEventMarshalErrMsg, err.Error())
 		return
 	}
 
-	q.RabbitProvider.SendMessage(quoteServiceExchangeName, marketDepthEventRkName, sendBody)
+	if err := q.RabbitProvider.SendReliableMessage(quoteServiceExchangeName, rk, sendBody); err != nil {
+		logger.Errorf(sendMessageErrMsg, rk, err.Error())
+	}
 }
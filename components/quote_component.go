@@ -18,43 +18,51 @@ var (
 	publishedScheduleQuotesEventMsg = "QuoteService published schedule QuotesEvent: %+v"
 )
 
-func (q *QuoteComponent) UpdateQuotes(byteMatchOrdersEvent []byte) {
-	var matchOrdersEvent proto.MatchOrdersEvent
-	if err := googleProto.Unmarshal(byteMatchOrdersEvent, &matchOrdersEvent); err != nil {
-		logger.Error(unmarshalMatchOrdersEventErrMsg)
-		return
+func (q *QuoteComponent) UpdateQuotes(byteMatchOrdersEvent []byte) error {
+	matchOrdersEvent, err := unmarshalMatchOrdersEvent(byteMatchOrdersEvent)
+	if err != nil {
+		return err
 	}
 
 	if matchOrdersEvent.Error != nil {
 		logger.Debugf(gotErrMatchOrdersEventMsg, matchOrdersEvent.String())
-		return
+		return nil
 	}
 
 	logger.Infof(gotMatchOrdersEventMsg, matchOrdersEvent.String())
 
+	tradeTime := getTradeTime(matchOrdersEvent)
 	matchedOrder := matchOrdersEvent.LimitMatchedOrder
 	currentQuotesEvent, err := q.Processing.UpdateQuotes(&matchedOrder.Pair, matchedOrder.InitPrice, matchOrdersEvent.MatchedVolume)
 	if err != nil {
 		logger.Debugf(quoteProcessingErr, err.Error())
-		return
+		return newListenerError(err)
 	}
 
+	// Candles, average prices and the ticker remember the match they added, so a redelivered event does not count the trade twice.
+	q.updateCandles(matchOrdersEvent, tradeTime)
+	q.updateAveragePrices(matchOrdersEvent, tradeTime)
+	q.updateTicker(matchOrdersEvent, tradeTime)
+
 	q.sendQuotesEvent(currentQuotesEvent)
 	logger.Infof(publishedQuotesEventMsg, currentQuotesEvent.String())
+	return nil
 }
 
 func (q *QuoteComponent) SendCurrentQuotesEventBySchedule(sendQuotesEventScheduleTime time.Duration) {
 	for {
 		time.Sleep(sendQuotesEventScheduleTime)
 
+		// An error skips only this QuotesEvent, the TickerEvent and the next schedules are still sent.
 		quotesEvent, err := q.Processing.GetQuotesEvent()
 		if err != nil {
 			logger.Errorf(quoteProcessingErr, err.Error())
-			return
+		} else {
+			q.sendQuotesEvent(quotesEvent)
+			logger.Infof(publishedScheduleQuotesEventMsg, quotesEvent.String())
 		}
 
-		q.sendQuotesEvent(quotesEvent)
-		logger.Infof(publishedScheduleQuotesEventMsg, quotesEvent.String())
+		q.sendTickerEvents()
 	}
 }
 
@@ -65,5 +73,7 @@ func (q *QuoteComponent) sendQuotesEvent(currentQuotesEvent *proto.QuotesEvent)
 		return
 	}
 
-	q.RabbitProvider.SendMessage(quoteServiceExchangeName, quotesEventRkName, sendBody)
+	if err := q.RabbitProvider.SendReliableMessage(quoteServiceExchangeName, quotesEventRkName, sendBody); err != nil {
+		logger.Errorf(sendMessageErrMsg, quotesEventRkName, err.Error())
+	}
 }
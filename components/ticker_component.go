@@ -0,0 +1,54 @@
+package components
+
+import (
+	"QuoteService/proto"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var (
+	pairTickerEventRkName = "rk.TickerEvent.%s"
+
+	tickerEventMarshalErrMsg = "Error while marshal TickerEvent: %s"
+	tickerProcessingErr      = "Error while processing ticker: %s"
+
+	publishedScheduleTickerEventMsg = "QuoteService published schedule TickerEvent: %+v"
+)
+
+func (q *QuoteComponent) updateTicker(matchOrdersEvent *proto.MatchOrdersEvent, tradeTime time.Time) {
+	matchedOrder := matchOrdersEvent.LimitMatchedOrder
+	if err := q.Processing.UpdateTicker(getMatchId(matchOrdersEvent), &matchedOrder.Pair, matchedOrder.InitPrice, matchOrdersEvent.MatchedVolume, tradeTime); err != nil {
+		logger.Errorf(tickerProcessingErr, err.Error())
+	}
+}
+
+// sendTickerEvents publishes the 24 hour TickerEvent of every active pair.
+func (q *QuoteComponent) sendTickerEvents() {
+	now := time.Now()
+	for _, stringPair := range q.Processing.Instruments.Pairs() {
+		tickerEvent, err := q.Processing.GetTickerEvent(stringPair, now)
+		if err != nil {
+			logger.Errorf(tickerProcessingErr, err.Error())
+			continue
+		}
+
+		q.sendTickerEvent(stringPair, tickerEvent)
+		logger.Infof(publishedScheduleTickerEventMsg, tickerEvent.String())
+	}
+}
+
+func (q *QuoteComponent) sendTickerEvent(pair string, tickerEvent *proto.TickerEvent) {
+	sendBody, err := googleProto.Marshal(tickerEvent)
+	if err != nil {
+		logger.Errorf(tickerEventMarshalErrMsg, err.Error())
+		return
+	}
+
+	rk := fmt.Sprintf(pairTickerEventRkName, pair)
+	if err := q.RabbitProvider.SendMessage(quoteServiceExchangeName, rk, sendBody); err != nil {
+		logger.Errorf(sendMessageErrMsg, rk, err.Error())
+	}
+}
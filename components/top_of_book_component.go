@@ -0,0 +1,72 @@
+package components
+
+import (
+	"QuoteService/proto"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var (
+	pairTopOfBookEventRkName = "rk.TopOfBookEvent.%s"
+
+	topOfBookEventMarshalErrMsg = "Error while marshal TopOfBookEvent: %s"
+	topOfBookProcessingErr      = "Error while processing top of book: %s"
+
+	unchangedTopOfBookMsg         = "Top of book for pair: %s did not change, skipping TopOfBookEvent"
+	publishedTopOfBookEventMsg    = "QuoteService published TopOfBookEvent: %+v"
+	publishedScheduleTopOfBookMsg = "QuoteService published schedule TopOfBookEvent: %+v"
+)
+
+func (q *QuoteComponent) SendTopOfBookEventBySchedule(sendTopOfBookEventScheduleTime time.Duration) {
+	for {
+		time.Sleep(sendTopOfBookEventScheduleTime)
+
+		for _, stringPair := range q.Processing.Instruments.Pairs() {
+			topOfBookEvent, err := q.Processing.GetTopOfBookEvent(stringPair)
+			if err != nil {
+				// The pair may have been disabled since Pairs was read, the other pairs are published anyway.
+				logger.Errorf(topOfBookProcessingErr, err.Error())
+				continue
+			}
+
+			q.sendTopOfBookEvent(topOfBookEvent)
+			logger.Infof(publishedScheduleTopOfBookMsg, topOfBookEvent.String())
+		}
+	}
+}
+
+// sendTopOfBookEventIfChanged publishes TopOfBookEvent after a book update only when the best bid or ask of the pair moved.
+func (q *QuoteComponent) sendTopOfBookEventIfChanged(pair proto.OrderPair) {
+	stringPair := q.Processing.Instruments.Name(pair)
+	topOfBookEvent, changed, err := q.Processing.UpdateTopOfBook(stringPair)
+	if err != nil {
+		logger.Errorf(topOfBookProcessingErr, err.Error())
+		return
+	}
+
+	if !changed {
+		logger.Debugf(unchangedTopOfBookMsg, stringPair)
+		return
+	}
+
+	q.sendTopOfBookEvent(topOfBookEvent)
+	logger.Infof(publishedTopOfBookEventMsg, topOfBookEvent.String())
+
+	q.sendArbitrageOpportunityEvents(stringPair)
+}
+
+func (q *QuoteComponent) sendTopOfBookEvent(topOfBookEvent *proto.TopOfBookEvent) {
+	sendBody, err := googleProto.Marshal(topOfBookEvent)
+	if err != nil {
+		logger.Errorf(topOfBookEventMarshalErrMsg, err.Error())
+		return
+	}
+
+	rk := fmt.Sprintf(pairTopOfBookEventRkName, q.Processing.Instruments.Name(topOfBookEvent.Pair))
+	if err := q.RabbitProvider.SendMessage(quoteServiceExchangeName, rk, sendBody); err != nil {
+		logger.Errorf(sendMessageErrMsg, rk, err.Error())
+	}
+}
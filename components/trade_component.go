@@ -0,0 +1,106 @@
+package components
+
+import (
+	"QuoteService/proto"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var (
+	pairTradeEventRkName = "rk.TradeEvent.%s"
+
+	tradeEventMarshalErrMsg               = "Error while marshal TradeEvent: %s"
+	unmarshalGetRecentTradesRequestErrMsg = "Error while unmarshal GetRecentTradesRequest: %s"
+	getRecentTradesResponseMarshalErrMsg  = "Error while marshal GetRecentTradesResponse: %s"
+	tradeProcessingErr                    = "Error while processing trades: %s"
+
+	publishedTradeEventMsg       = "QuoteService published TradeEvent: %+v"
+	gotGetRecentTradesRequestMsg = "QuoteService got GetRecentTradesRequest: %s"
+)
+
+// RecordTradeByMatchOrdersEvent adds every match to the trade tape of its pair and publishes it as TradeEvent.
+func (q *QuoteComponent) RecordTradeByMatchOrdersEvent(byteMatchOrdersEvent []byte) error {
+	matchOrdersEvent, err := unmarshalMatchOrdersEvent(byteMatchOrdersEvent)
+	if err != nil {
+		return err
+	}
+
+	if matchOrdersEvent.Error != nil {
+		logger.Debugf(gotErrMatchOrdersEventMsg, matchOrdersEvent.String())
+		return nil
+	}
+
+	logger.Infof(gotMatchOrdersEventMsg, matchOrdersEvent.String())
+
+	tradeEvent, err := q.Processing.AddTrade(matchOrdersEvent, getTradeTime(matchOrdersEvent))
+	if err != nil {
+		logger.Errorf(tradeProcessingErr, err.Error())
+		return newListenerError(err)
+	}
+
+	q.sendTradeEvent(tradeEvent)
+	logger.Infof(publishedTradeEventMsg, tradeEvent.String())
+	return nil
+}
+
+// getTradeTime returns when the match happened, the updated date in unix milliseconds the orders got with it,
+// so a redelivered or retried event is counted at its time and not when it is processed. Events without
+// the date are counted at now.
+func getTradeTime(matchOrdersEvent *proto.MatchOrdersEvent) time.Time {
+	for _, order := range []*proto.Order{matchOrdersEvent.CreatedMatchedOrder, matchOrdersEvent.LimitMatchedOrder} {
+		if order.UpdatedDate > 0 {
+			return time.UnixMilli(order.UpdatedDate)
+		}
+	}
+	return time.Now()
+}
+
+// GetRecentTrades replies to GetRecentTradesRequest with the latest trades of the pair.
+func (q *QuoteComponent) GetRecentTrades(byteGetRecentTradesRequest []byte) []byte {
+	var getRecentTradesRequest proto.GetRecentTradesRequest
+	if err := googleProto.Unmarshal(byteGetRecentTradesRequest, &getRecentTradesRequest); err != nil {
+		logger.Errorf(unmarshalGetRecentTradesRequestErrMsg, err.Error())
+		return q.marshalGetRecentTradesResponse(&proto.GetRecentTradesResponse{
+			Error: &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()},
+		})
+	}
+
+	logger.Infof(gotGetRecentTradesRequestMsg, getRecentTradesRequest.String())
+
+	getRecentTradesResponse := &proto.GetRecentTradesResponse{Pair: getRecentTradesRequest.Pair}
+	trades, err := q.Processing.GetRecentTrades(q.Processing.Instruments.Name(getRecentTradesRequest.Pair), int(getRecentTradesRequest.Limit))
+	if err != nil {
+		logger.Errorf(tradeProcessingErr, err.Error())
+		getRecentTradesResponse.Error = newErrorDto(err)
+		return q.marshalGetRecentTradesResponse(getRecentTradesResponse)
+	}
+
+	getRecentTradesResponse.Trades = trades
+	return q.marshalGetRecentTradesResponse(getRecentTradesResponse)
+}
+
+func (q *QuoteComponent) marshalGetRecentTradesResponse(getRecentTradesResponse *proto.GetRecentTradesResponse) []byte {
+	sendBody, err := googleProto.Marshal(getRecentTradesResponse)
+	if err != nil {
+		logger.Errorf(getRecentTradesResponseMarshalErrMsg, err.Error())
+		return nil
+	}
+
+	return sendBody
+}
+
+func (q *QuoteComponent) sendTradeEvent(tradeEvent *proto.TradeEvent) {
+	sendBody, err := googleProto.Marshal(tradeEvent)
+	if err != nil {
+		logger.Errorf(tradeEventMarshalErrMsg, err.Error())
+		return
+	}
+
+	rk := fmt.Sprintf(pairTradeEventRkName, q.Processing.Instruments.Name(tradeEvent.Trade.Pair))
+	if err := q.RabbitProvider.SendMessage(quoteServiceExchangeName, rk, sendBody); err != nil {
+		logger.Errorf(sendMessageErrMsg, rk, err.Error())
+	}
+}
@@ -2,59 +2,181 @@ package main
 
 import (
 	"QuoteService/components"
+	"QuoteService/models"
 	"QuoteService/processing"
+	"QuoteService/proto"
 	"QuoteService/providers"
+	"QuoteService/registry"
 	"QuoteService/sandbox"
+	"QuoteService/utils"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	logger "github.com/sirupsen/logrus"
 )
 
 var (
 	sendMarketDepthEventScheduleTime = 10 * time.Second
 	sendQuotesEventScheduleTime      = 10 * time.Second
+	sendTopOfBookEventScheduleTime   = 10 * time.Second
+	sendCandleEventsScheduleTime     = time.Second
+
+	maxMarketDepthLevels       = 50
+	maxMarketDepthLevelsByPair = map[string]int{}
+
+	maxTradesPerPair int64 = 10000
+
+	averagePriceWindows = []models.AveragePriceWindowModel{
+		{Name: "1m", Duration: time.Minute},
+		{Name: "1h", Duration: time.Hour},
+		{Name: "session", Session: true},
+	}
+	sessionStart = 0 * time.Hour
+
+	arbitrageFeeRate          = 0.001
+	arbitrageFeeRateByPair    = map[string]float64{}
+	minArbitrageProfitPercent = 0.0
+
+	liquidityDepthPercents = []float64{0.1, 0.5, 1, 2}
+
+	publishAllPairsMarketDepthEvent = false
+
+	httpServerAddress         = ":8080"
+	getCandlesHttpPath        = "/candles"
+	getRecentTradesHttpPath   = "/trades"
+	getAveragePricesHttpPath  = "/average-prices"
+	estimateFillHttpPath      = "/estimate-fill"
+	metricsHttpPath           = "/metrics"
+	getDeadLettersHttpPath    = "/dead-letters"
+	replayDeadLettersHttpPath = "/dead-letters/replay"
+
+	instrumentsConfigPath          = "config/instruments.json"
+	topologyConfigPath             = "config/topology.json"
+	refreshInstrumentsScheduleTime = 10 * time.Second
 
 	orderProcessingExchangeName = "ex.OrderProcessingService"
 	quoteServiceExchangeName    = "ex.QuoteService"
 
-	createOrderResponseRkName = "rk.CreateOrderResponse"
-	matchOrdersEventRkName    = "rk.MatchOrdersEvent"
-	removeOrderResponseRkName = "rk.RemoveOrderResponse"
+	createOrderResponseRkName      = "rk.CreateOrderResponse"
+	matchOrdersEventRkName         = "rk.MatchOrdersEvent"
+	removeOrderResponseRkName      = "rk.RemoveOrderResponse"
+	getMarketDepthRequestRkName    = "rk.GetMarketDepthRequest"
+	snapshotRequestRkName          = "rk.MarketDepthSnapshotRequest"
+	getCandlesRequestRkName        = "rk.GetCandlesRequest"
+	getRecentTradesRequestRkName   = "rk.GetRecentTradesRequest"
+	getAveragePricesRequestRkName  = "rk.GetAveragePricesRequest"
+	estimateFillRequestRkName      = "rk.EstimateFillRequest"
+	getDeadLettersRequestRkName    = "rk.GetDeadLettersRequest"
+	replayDeadLettersRequestRkName = "rk.ReplayDeadLettersRequest"
 
 	createOrderResponseListenerQueueName         = "q.QuoteService.CreateOrderResponse.Listener"
 	removeOrderResponseListenerQueueName         = "q.QuoteService.RemoveOrderResponse.Listener"
 	marketDepthMatchOrdersEventListenerQueueName = "q.QuoteService.MarketDepth.MatchOrdersEvent.Listener"
 	quotesMatchOrdersEventListenerQueueName      = "q.QuoteService.Quotes.MatchOrdersEvent.Listener"
+	getMarketDepthRequestListenerQueueName       = "q.QuoteService.GetMarketDepthRequest.Listener"
+	snapshotRequestListenerQueueName             = "q.QuoteService.MarketDepthSnapshotRequest.Listener"
+	getCandlesRequestListenerQueueName           = "q.QuoteService.GetCandlesRequest.Listener"
+	getRecentTradesRequestListenerQueueName      = "q.QuoteService.GetRecentTradesRequest.Listener"
+	getAveragePricesRequestListenerQueueName     = "q.QuoteService.GetAveragePricesRequest.Listener"
+	estimateFillRequestListenerQueueName         = "q.QuoteService.EstimateFillRequest.Listener"
+	getDeadLettersRequestListenerQueueName       = "q.QuoteService.GetDeadLettersRequest.Listener"
+	replayDeadLettersRequestListenerQueueName    = "q.QuoteService.ReplayDeadLettersRequest.Listener"
+	tradesMatchOrdersEventListenerQueueName      = "q.QuoteService.Trades.MatchOrdersEvent.Listener"
 )
 
 func main() {
-	rabbitProvider := providers.NewRabbitProvider()
+	topology, err := providers.LoadTopology(topologyConfigPath)
+	utils.CheckErrorWithPanic(err)
+
+	rabbitProvider := providers.NewRabbitProvider(topology)
+	defer rabbitProvider.Close()
 	redisClient := providers.NewRedisClient()
 	defer redisClient.Close()
 
-	quoteProcessing := &processing.QuoteProcessing{RedisClient: redisClient}
-	quoteComponent := &components.QuoteComponent{RabbitProvider: rabbitProvider, Processing: quoteProcessing}
-
-	sandbox := &sandbox.Sandbox{RabbitProvider: rabbitProvider}
-
-	rabbitProvider.DeclareExchange(quoteServiceExchangeName)
+	instrumentRegistry, err := registry.NewInstrumentRegistry(redisClient, instrumentsConfigPath)
+	utils.CheckErrorWithPanic(err)
+
+	quoteProcessing := &processing.QuoteProcessing{
+		RedisClient:                redisClient,
+		MaxMarketDepthLevels:       maxMarketDepthLevels,
+		MaxMarketDepthLevelsByPair: maxMarketDepthLevelsByPair,
+		MaxTradesPerPair:           maxTradesPerPair,
+		AveragePriceWindows:        averagePriceWindows,
+		SessionStart:               sessionStart,
+		ArbitrageFeeRate:           arbitrageFeeRate,
+		ArbitrageFeeRateByPair:     arbitrageFeeRateByPair,
+		MinArbitrageProfitPercent:  minArbitrageProfitPercent,
+		LiquidityDepthPercents:     liquidityDepthPercents,
+		Instruments:                instrumentRegistry,
+	}
+	utils.CheckErrorWithPanic(quoteProcessing.CheckAveragePriceWindows())
+	utils.CheckErrorWithPanic(quoteProcessing.MigrateMarketDepth())
+
+	quoteComponent := &components.QuoteComponent{
+		RabbitProvider:                  rabbitProvider,
+		Processing:                      quoteProcessing,
+		PublishAllPairsMarketDepthEvent: publishAllPairsMarketDepthEvent,
+	}
+
+	sandbox := &sandbox.Sandbox{RabbitProvider: rabbitProvider, Instruments: instrumentRegistry}
+
+	utils.CheckErrorWithPanic(rabbitProvider.DeclareExchange(quoteServiceExchangeName))
+
+	go instrumentRegistry.RefreshBySchedule(refreshInstrumentsScheduleTime)
 
 	go quoteComponent.SendMarketDepthEventBySchedule(sendMarketDepthEventScheduleTime)
 	go quoteComponent.SendCurrentQuotesEventBySchedule(sendQuotesEventScheduleTime)
+	go quoteComponent.SendTopOfBookEventBySchedule(sendTopOfBookEventScheduleTime)
+	go quoteComponent.SendClosedCandleEventsBySchedule(sendCandleEventsScheduleTime)
 
 	go sandbox.RunSandbox()
 
+	go runHttpServer(quoteComponent)
+
+	go closeOnSignal(rabbitProvider)
+
 	runListeners(rabbitProvider, quoteComponent)
 }
 
 func runListeners(rabbitProvider *providers.RabbitProvider, quoteComponent *components.QuoteComponent) {
-	msgs, ch := rabbitProvider.GetQueueConsumer(orderProcessingExchangeName, createOrderResponseRkName, createOrderResponseListenerQueueName)
-	go rabbitProvider.RunListener(msgs, ch, quoteComponent.UpdateMarketDepthByCreateOrderResponse)
+	go rabbitProvider.RunListener(orderProcessingExchangeName, createOrderResponseRkName, createOrderResponseListenerQueueName, quoteComponent.UpdateMarketDepthByCreateOrderResponse)
+	go rabbitProvider.RunListener(orderProcessingExchangeName, removeOrderResponseRkName, removeOrderResponseListenerQueueName, quoteComponent.UpdateMarketDepthByRemoveOrderResponse)
+	go rabbitProvider.RunRpcListener(quoteServiceExchangeName, getMarketDepthRequestRkName, getMarketDepthRequestListenerQueueName, quoteComponent.GetMarketDepth)
+	go rabbitProvider.RunRpcListener(quoteServiceExchangeName, snapshotRequestRkName, snapshotRequestListenerQueueName, quoteComponent.GetMarketDepthSnapshot)
+	go rabbitProvider.RunRpcListener(quoteServiceExchangeName, getCandlesRequestRkName, getCandlesRequestListenerQueueName, quoteComponent.GetCandles)
+	go rabbitProvider.RunRpcListener(quoteServiceExchangeName, getRecentTradesRequestRkName, getRecentTradesRequestListenerQueueName, quoteComponent.GetRecentTrades)
+	go rabbitProvider.RunRpcListener(quoteServiceExchangeName, getAveragePricesRequestRkName, getAveragePricesRequestListenerQueueName, quoteComponent.GetAveragePrices)
+	go rabbitProvider.RunRpcListener(quoteServiceExchangeName, estimateFillRequestRkName, estimateFillRequestListenerQueueName, quoteComponent.EstimateFill)
+	go rabbitProvider.RunRpcListener(quoteServiceExchangeName, getDeadLettersRequestRkName, getDeadLettersRequestListenerQueueName, quoteComponent.GetDeadLetters)
+	go rabbitProvider.RunRpcListener(quoteServiceExchangeName, replayDeadLettersRequestRkName, replayDeadLettersRequestListenerQueueName, quoteComponent.ReplayDeadLetters)
+	go rabbitProvider.RunListener(orderProcessingExchangeName, matchOrdersEventRkName, tradesMatchOrdersEventListenerQueueName, quoteComponent.RecordTradeByMatchOrdersEvent)
+	go rabbitProvider.RunListener(orderProcessingExchangeName, matchOrdersEventRkName, quotesMatchOrdersEventListenerQueueName, quoteComponent.UpdateQuotes)
+	rabbitProvider.RunListener(orderProcessingExchangeName, matchOrdersEventRkName, marketDepthMatchOrdersEventListenerQueueName, quoteComponent.UpdateMarketDepthByMatchOrdersEvent)
+}
 
-	msgs, ch = rabbitProvider.GetQueueConsumer(orderProcessingExchangeName, removeOrderResponseRkName, removeOrderResponseListenerQueueName)
-	go rabbitProvider.RunListener(msgs, ch, quoteComponent.UpdateMarketDepthByRemoveOrderResponse)
+// closeOnSignal closes the rabbit provider on SIGINT or SIGTERM, so the listeners return and main can shut down.
+func closeOnSignal(rabbitProvider *providers.RabbitProvider) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	<-signals
 
-	msgs, ch = rabbitProvider.GetQueueConsumer(orderProcessingExchangeName, matchOrdersEventRkName, quotesMatchOrdersEventListenerQueueName)
-	go rabbitProvider.RunListener(msgs, ch, quoteComponent.UpdateQuotes)
+	if err := rabbitProvider.Close(); err != nil {
+		logger.Error(err)
+	}
+}
 
-	msgs, ch = rabbitProvider.GetQueueConsumer(orderProcessingExchangeName, matchOrdersEventRkName, marketDepthMatchOrdersEventListenerQueueName)
-	rabbitProvider.RunListener(msgs, ch, quoteComponent.UpdateMarketDepthByMatchOrdersEvent)
+func runHttpServer(quoteComponent *components.QuoteComponent) {
+	httpProvider := providers.NewHttpProvider(httpServerAddress)
+	httpProvider.HandleRpc(getCandlesHttpPath, &proto.GetCandlesRequest{}, &proto.GetCandlesResponse{}, quoteComponent.GetCandles)
+	httpProvider.HandleRpc(getRecentTradesHttpPath, &proto.GetRecentTradesRequest{}, &proto.GetRecentTradesResponse{}, quoteComponent.GetRecentTrades)
+	httpProvider.HandleRpc(getAveragePricesHttpPath, &proto.GetAveragePricesRequest{}, &proto.GetAveragePricesResponse{}, quoteComponent.GetAveragePrices)
+	httpProvider.HandleRpc(estimateFillHttpPath, &proto.EstimateFillRequest{}, &proto.EstimateFillResponse{}, quoteComponent.EstimateFill)
+	httpProvider.HandleRpc(getDeadLettersHttpPath, &proto.GetDeadLettersRequest{}, &proto.GetDeadLettersResponse{}, quoteComponent.GetDeadLetters)
+	httpProvider.HandlePostRpc(replayDeadLettersHttpPath, &proto.ReplayDeadLettersRequest{}, &proto.ReplayDeadLettersResponse{}, quoteComponent.ReplayDeadLetters)
+	httpProvider.Handle(metricsHttpPath, promhttp.Handler())
+
+	utils.CheckErrorWithPanic(httpProvider.ListenAndServe())
 }
@@ -1,5 +1,12 @@
 package models
 
+import (
+	"QuoteService/proto"
+	"QuoteService/utils"
+	"strconv"
+	"time"
+)
+
 type PairMarketDepthModel struct {
 	OrderPair           string
 	OrderDirection      string
@@ -16,3 +23,40 @@ type PairQuoteModel struct {
 	Price     float64
 	Volume    float64
 }
+
+// AveragePriceWindowModel is a window VWAP and TWAP are calculated over: the last Duration,
+// or the current trading session when Session is set.
+type AveragePriceWindowModel struct {
+	Name     string
+	Duration time.Duration
+	Session  bool
+}
+
+type InstrumentModel struct {
+	Id              proto.OrderPair
+	Pair            string
+	BaseCurrency    string
+	QuoteCurrency   string
+	PriceTick       utils.Step
+	VolumeStep      utils.Step
+	PricePrecision  int
+	VolumePrecision int
+	Enabled         bool
+}
+
+// FormatPrice formats a price with the display precision of the instrument.
+func (i *InstrumentModel) FormatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', i.PricePrecision, 64)
+}
+
+// FormatVolume formats a volume with the display precision of the instrument.
+func (i *InstrumentModel) FormatVolume(volume float64) string {
+	return strconv.FormatFloat(volume, 'f', i.VolumePrecision, 64)
+}
+
+// RoundPrice rounds a calculated price, such as an average or an implied one, to the display precision
+// of the instrument it is published with.
+func (i *InstrumentModel) RoundPrice(price float64) float64 {
+	roundedPrice, _ := strconv.ParseFloat(i.FormatPrice(price), 64)
+	return roundedPrice
+}
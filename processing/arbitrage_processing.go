@@ -0,0 +1,173 @@
+package processing
+
+import (
+	"QuoteService/models"
+	"QuoteService/proto"
+	"math"
+)
+
+// arbitrageCycle is a round trip from currency through three pairs back to currency.
+type arbitrageCycle struct {
+	currency string
+	legs     [3]crossRateLeg
+}
+
+// GetArbitrageOpportunityEvents checks every round trip through three active pairs that includes pair
+// at the current best bid and ask and returns the ones that are profitable after fees.
+func (q *QuoteProcessing) GetArbitrageOpportunityEvents(pair string) ([]*proto.ArbitrageOpportunityEvent, error) {
+	topOfBookByPair := map[string]*proto.TopOfBookEvent{}
+
+	var arbitrageOpportunityEvents []*proto.ArbitrageOpportunityEvent
+	for _, cycle := range q.getArbitrageCycles() {
+		if !cycle.includes(pair) {
+			continue
+		}
+
+		for _, leg := range cycle.legs {
+			if _, exists := topOfBookByPair[leg.instrument.Pair]; exists {
+				continue
+			}
+
+			topOfBookEvent, err := q.GetTopOfBookEvent(leg.instrument.Pair)
+			if err != nil {
+				return nil, err
+			}
+			topOfBookByPair[leg.instrument.Pair] = topOfBookEvent
+		}
+
+		if arbitrageOpportunityEvent := q.getArbitrageOpportunity(cycle, topOfBookByPair); arbitrageOpportunityEvent != nil {
+			arbitrageOpportunityEvents = append(arbitrageOpportunityEvents, arbitrageOpportunityEvent)
+		}
+	}
+
+	return arbitrageOpportunityEvents, nil
+}
+
+// getArbitrageOpportunity returns the opportunity of the cycle, nil when a leg has no best level
+// or the round trip does not return more than MinArbitrageProfitPercent.
+func (q *QuoteProcessing) getArbitrageOpportunity(cycle arbitrageCycle, topOfBookByPair map[string]*proto.TopOfBookEvent) *proto.ArbitrageOpportunityEvent {
+	var levels [3]*proto.VolumeByPrice
+	var rates [3]float64
+	// Every leg can take at most the volume of its best level, converted to the cycle currency.
+	size, rate := math.Inf(1), 1.0
+	for i, leg := range cycle.legs {
+		topOfBookEvent := topOfBookByPair[leg.instrument.Pair]
+
+		// Selling the base currency takes the best bid, buying it with the quote currency the best ask.
+		var capacity float64
+		if leg.inverted {
+			levels[i] = topOfBookEvent.BestAsk
+			if levels[i] == nil || levels[i].Price <= 0 {
+				return nil
+			}
+			rates[i] = 1 / levels[i].Price
+			capacity = levels[i].Volume * levels[i].Price
+		} else {
+			levels[i] = topOfBookEvent.BestBid
+			if levels[i] == nil || levels[i].Price <= 0 {
+				return nil
+			}
+			rates[i] = levels[i].Price
+			capacity = levels[i].Volume
+		}
+		rates[i] *= 1 - q.getArbitrageFeeRate(leg.instrument.Pair)
+
+		size = math.Min(size, capacity/rate)
+		rate *= rates[i]
+	}
+
+	profitPercent := (rate - 1) * 100
+	if profitPercent <= q.MinArbitrageProfitPercent || size <= 0 {
+		return nil
+	}
+
+	arbitrageOpportunityEvent := &proto.ArbitrageOpportunityEvent{
+		Currency:      cycle.currency,
+		Size:          size,
+		Profit:        size * (rate - 1),
+		ProfitPercent: profitPercent,
+	}
+
+	amount, fromCurrency := size, cycle.currency
+	for i, leg := range cycle.legs {
+		arbitrageLeg := &proto.ArbitrageLeg{
+			Pair:         leg.instrument.Id,
+			Price:        levels[i].Price,
+			FromCurrency: fromCurrency,
+			ToCurrency:   leg.otherCurrency(fromCurrency),
+		}
+		if leg.inverted {
+			arbitrageLeg.Direction, arbitrageLeg.Volume = proto.OrderDirection_BUY, amount/levels[i].Price
+		} else {
+			arbitrageLeg.Direction, arbitrageLeg.Volume = proto.OrderDirection_SELL, amount
+		}
+		arbitrageOpportunityEvent.Legs = append(arbitrageOpportunityEvent.Legs, arbitrageLeg)
+
+		amount, fromCurrency = amount*rates[i], arbitrageLeg.ToCurrency
+	}
+
+	return arbitrageOpportunityEvent
+}
+
+// getArbitrageCycles returns every round trip through three active pairs once per direction,
+// starting with the pair with the lowest OrderPair value.
+func (q *QuoteProcessing) getArbitrageCycles() []arbitrageCycle {
+	var instruments []*models.InstrumentModel
+	for _, stringPair := range q.Instruments.Pairs() {
+		if instrument, exists := q.Instruments.Get(stringPair); exists {
+			instruments = append(instruments, instrument)
+		}
+	}
+
+	var cycles []arbitrageCycle
+	// Pairs are ordered by OrderPair value, so only later pairs follow the first one.
+	for i, first := range instruments {
+		for _, currency := range []string{first.BaseCurrency, first.QuoteCurrency} {
+			firstLeg, firstCurrency, _ := getCrossRateLeg(first, currency)
+
+			for j, second := range instruments[i+1:] {
+				secondLeg, secondCurrency, ok := getCrossRateLeg(second, firstCurrency)
+				if !ok || secondCurrency == currency {
+					continue
+				}
+
+				for _, third := range instruments[i+1:] {
+					if third == instruments[i+1+j] {
+						continue
+					}
+					thirdLeg, thirdCurrency, ok := getCrossRateLeg(third, secondCurrency)
+					if !ok || thirdCurrency != currency {
+						continue
+					}
+
+					cycles = append(cycles, arbitrageCycle{currency: currency, legs: [3]crossRateLeg{firstLeg, secondLeg, thirdLeg}})
+				}
+			}
+		}
+	}
+
+	return cycles
+}
+
+func (q *QuoteProcessing) getArbitrageFeeRate(pair string) float64 {
+	if feeRate, exists := q.ArbitrageFeeRateByPair[pair]; exists {
+		return feeRate
+	}
+	return q.ArbitrageFeeRate
+}
+
+func (c arbitrageCycle) includes(pair string) bool {
+	for _, leg := range c.legs {
+		if leg.instrument.Pair == pair {
+			return true
+		}
+	}
+	return false
+}
+
+func (l crossRateLeg) otherCurrency(currency string) string {
+	if currency == l.instrument.BaseCurrency {
+		return l.instrument.QuoteCurrency
+	}
+	return l.instrument.BaseCurrency
+}
@@ -0,0 +1,130 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"math"
+	"testing"
+)
+
+func TestGetArbitrageOpportunity(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	usdEur, _ := q.Instruments.Get("USD_EUR")
+	uahEur, _ := q.Instruments.Get("UAH_EUR")
+	usdUah, _ := q.Instruments.Get("USD_UAH")
+	// USD is sold for EUR at the USD_EUR bid, EUR buys UAH at the UAH_EUR ask and UAH buys USD at the USD_UAH ask.
+	cycle := arbitrageCycle{currency: "USD", legs: [3]crossRateLeg{
+		{instrument: usdEur},
+		{instrument: uahEur, inverted: true},
+		{instrument: usdUah, inverted: true},
+	}}
+
+	tests := []struct {
+		name          string
+		feeRate       float64
+		usdUahAsk     *proto.VolumeByPrice
+		uahEurAsk     *proto.VolumeByPrice
+		wantSize      float64
+		wantProfit    float64
+		wantLegVolume [3]float64
+		wantNil       bool
+	}{
+		{
+			name:          "size is limited by the inverted middle leg",
+			usdUahAsk:     &proto.VolumeByPrice{Price: 1.25, Volume: 100},
+			uahEurAsk:     &proto.VolumeByPrice{Price: 0.25, Volume: 40},
+			wantSize:      20,
+			wantProfit:    12,
+			wantLegVolume: [3]float64{20, 40, 32},
+		},
+		{
+			name:          "size is limited by the last leg",
+			usdUahAsk:     &proto.VolumeByPrice{Price: 1.25, Volume: 8},
+			uahEurAsk:     &proto.VolumeByPrice{Price: 0.25, Volume: 40},
+			wantSize:      5,
+			wantProfit:    3,
+			wantLegVolume: [3]float64{5, 10, 8},
+		},
+		{
+			name:      "break even is not an opportunity",
+			usdUahAsk: &proto.VolumeByPrice{Price: 2, Volume: 100},
+			uahEurAsk: &proto.VolumeByPrice{Price: 0.25, Volume: 40},
+			wantNil:   true,
+		},
+		{
+			name:      "loss is not an opportunity",
+			usdUahAsk: &proto.VolumeByPrice{Price: 4, Volume: 100},
+			uahEurAsk: &proto.VolumeByPrice{Price: 0.25, Volume: 40},
+			wantNil:   true,
+		},
+		{
+			name:      "fees turn the profit into a loss",
+			feeRate:   0.2,
+			usdUahAsk: &proto.VolumeByPrice{Price: 1.25, Volume: 100},
+			uahEurAsk: &proto.VolumeByPrice{Price: 0.25, Volume: 40},
+			wantNil:   true,
+		},
+		{
+			name:      "missing best level",
+			usdUahAsk: &proto.VolumeByPrice{Price: 1.25, Volume: 100},
+			wantNil:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q.ArbitrageFeeRate = tt.feeRate
+			topOfBookByPair := map[string]*proto.TopOfBookEvent{
+				"USD_EUR": {BestBid: &proto.VolumeByPrice{Price: 0.5, Volume: 100}},
+				"UAH_EUR": {BestAsk: tt.uahEurAsk},
+				"USD_UAH": {BestAsk: tt.usdUahAsk},
+			}
+
+			arbitrageOpportunityEvent := q.getArbitrageOpportunity(cycle, topOfBookByPair)
+			if tt.wantNil {
+				if arbitrageOpportunityEvent != nil {
+					t.Errorf("getArbitrageOpportunity() = %v, want nil", arbitrageOpportunityEvent)
+				}
+				return
+			}
+			if arbitrageOpportunityEvent == nil {
+				t.Fatalf("getArbitrageOpportunity() = nil, want an opportunity")
+			}
+
+			if !almostEqual(arbitrageOpportunityEvent.Size, tt.wantSize) || !almostEqual(arbitrageOpportunityEvent.Profit, tt.wantProfit) {
+				t.Errorf("size %v, profit %v, want %v, %v", arbitrageOpportunityEvent.Size, arbitrageOpportunityEvent.Profit, tt.wantSize, tt.wantProfit)
+			}
+			wantDirections := [3]proto.OrderDirection{proto.OrderDirection_SELL, proto.OrderDirection_BUY, proto.OrderDirection_BUY}
+			for i, leg := range arbitrageOpportunityEvent.Legs {
+				if leg.Direction != wantDirections[i] || !almostEqual(leg.Volume, tt.wantLegVolume[i]) {
+					t.Errorf("leg %d = %s %v %s, want %s %v", i, leg.Direction, leg.Volume, leg.Pair, wantDirections[i], tt.wantLegVolume[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetArbitrageCycles(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+
+	cycles := q.getArbitrageCycles()
+	// Three pairs make one triangle, walked once in each direction.
+	if len(cycles) != 2 {
+		t.Fatalf("len(getArbitrageCycles()) = %d, want 2", len(cycles))
+	}
+	for _, cycle := range cycles {
+		currency := cycle.currency
+		for _, leg := range cycle.legs {
+			if leg.inverted != (currency == leg.instrument.QuoteCurrency) {
+				t.Errorf("leg %s from %s inverted = %v", leg.instrument.Pair, currency, leg.inverted)
+			}
+			currency = leg.otherCurrency(currency)
+		}
+		if currency != cycle.currency {
+			t.Errorf("cycle from %s ends in %s", cycle.currency, currency)
+		}
+	}
+}
+
+func almostEqual(got, want float64) bool {
+	return math.Abs(got-want) < 1e-9
+}
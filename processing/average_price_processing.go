@@ -0,0 +1,247 @@
+package processing
+
+import (
+	"QuoteService/models"
+	"QuoteService/proto"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// priceBuckets is a ring of fixed size hashes in redis aggregating the trades of a pair per resolution:
+// the slot of a bucket is its time divided by the resolution modulo size, so a slot is reused once
+// the bucket in it is older than size resolutions and the ring never grows.
+type priceBuckets struct {
+	key        string
+	resolution time.Duration
+	size       int64
+}
+
+var (
+	secondPriceBuckets = priceBuckets{key: "averageprice:%s:seconds", resolution: time.Second, size: 600}
+	minutePriceBuckets = priceBuckets{key: "averageprice:%s:minutes", resolution: time.Minute, size: 1500}
+
+	averagePriceAppliedKey = "averageprice:%s:applied:%s"
+
+	sessionDuration = 24 * time.Hour
+
+	invalidAveragePriceWindowErrMsg = "invalid average price window: %s, it must be a session or last from %v to %v"
+	invalidSessionStartErrMsg       = "invalid session start: %v, it must be within a day"
+	invalidPriceBucketErrMsg        = "invalid price bucket %s for pair: %s"
+)
+
+// updatePriceBucketsScript adds a trade of ARGV[2] price ticks, ARGV[3] volume steps and ARGV[4] quote volume to
+// the bucket of every ring in KEYS[2] and after. The trade is remembered in KEYS[1] for ARGV[1] seconds, a trade
+// already remembered there is not added again and replies 0. ARGV[3 + 2 * i] is the slot and ARGV[4 + 2 * i]
+// the bucket time for the ring in KEYS[1 + i], a slot still holding an older bucket is reset first.
+var updatePriceBucketsScript = redis.NewScript(`
+if not redis.call('SET', KEYS[1], 1, 'NX', 'EX', ARGV[1]) then
+	return 0
+end
+for i = 1, #KEYS - 1 do
+	local ring, slot, bucketTime = KEYS[1 + i], ARGV[3 + 2 * i], ARGV[4 + 2 * i]
+	if redis.call('HGET', ring, slot .. ':time') ~= bucketTime then
+		redis.call('HSET', ring, slot .. ':time', bucketTime, slot .. ':quoteVolume', 0, slot .. ':volume', 0)
+	end
+	redis.call('HINCRBY', ring, slot .. ':quoteVolume', ARGV[4])
+	redis.call('HINCRBY', ring, slot .. ':volume', ARGV[3])
+	redis.call('HSET', ring, slot .. ':close', ARGV[2])
+end
+return 1
+`)
+
+// priceBucket aggregates the trades of one resolution: volume in steps, quote volume in ticks times steps
+// and the last price in ticks.
+type priceBucket struct {
+	time                int64
+	quoteVolume, volume int64
+	close               int64
+}
+
+// CheckAveragePriceWindows rejects windows longer than the trades kept to calculate them.
+func (q *QuoteProcessing) CheckAveragePriceWindows() error {
+	if q.SessionStart < 0 || q.SessionStart >= sessionDuration {
+		return fmt.Errorf(invalidSessionStartErrMsg, q.SessionStart)
+	}
+
+	maxDuration := minutePriceBuckets.resolution * time.Duration(minutePriceBuckets.size-1)
+	for _, window := range q.AveragePriceWindows {
+		if window.Session {
+			continue
+		}
+		if window.Duration < secondPriceBuckets.resolution || window.Duration > maxDuration {
+			return fmt.Errorf(invalidAveragePriceWindowErrMsg, window.Name, secondPriceBuckets.resolution, maxDuration)
+		}
+	}
+
+	return nil
+}
+
+// UpdateAveragePrices adds a matched trade to the price buckets VWAP and TWAP of the pair are calculated from.
+// matchId names the match, a match with the same id is added only once.
+func (q *QuoteProcessing) UpdateAveragePrices(matchId string, pair *proto.OrderPair, price, volume float64, tradeTime time.Time) error {
+	stringPair := q.Instruments.Name(*pair)
+	instrument, err := q.getInstrument(stringPair)
+	if err != nil {
+		return err
+	}
+	priceTicks, err := getPriceTicks(instrument, price)
+	if err != nil {
+		return err
+	}
+	volumeSteps, err := getVolumeSteps(instrument, volume)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{fmt.Sprintf(averagePriceAppliedKey, stringPair, matchId)}
+	args := []interface{}{int64(tradeRecordedTtl.Seconds()), priceTicks, volumeSteps, priceTicks * volumeSteps}
+	for _, buckets := range []priceBuckets{secondPriceBuckets, minutePriceBuckets} {
+		bucketTime := buckets.getBucketTime(tradeTime)
+		keys = append(keys, fmt.Sprintf(buckets.key, stringPair))
+		args = append(args, buckets.getSlot(bucketTime), bucketTime)
+	}
+
+	return updatePriceBucketsScript.Run(context.Background(), q.RedisClient, keys, args...).Err()
+}
+
+// GetAveragePrices returns VWAP and TWAP of the pair over every configured window at now.
+func (q *QuoteProcessing) GetAveragePrices(pair string, now time.Time) ([]*proto.AveragePrice, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketsCmds := map[priceBuckets]*redis.MapStringStringCmd{}
+	_, err = q.RedisClient.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		for _, buckets := range []priceBuckets{secondPriceBuckets, minutePriceBuckets} {
+			bucketsCmds[buckets] = pipe.HGetAll(context.Background(), fmt.Sprintf(buckets.key, pair))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bucketsByResolution := map[priceBuckets][]*priceBucket{}
+	for buckets, bucketsCmd := range bucketsCmds {
+		if bucketsByResolution[buckets], err = parsePriceBuckets(pair, bucketsCmd.Val()); err != nil {
+			return nil, err
+		}
+	}
+
+	averagePrices := make([]*proto.AveragePrice, 0, len(q.AveragePriceWindows))
+	for _, window := range q.AveragePriceWindows {
+		buckets := q.getWindowPriceBuckets(window)
+		toTime := buckets.getBucketTime(now) + buckets.resolution.Milliseconds()
+		fromTime := toTime - window.Duration.Milliseconds()
+		if window.Session {
+			fromTime = q.getSessionStartTime(now)
+		}
+
+		averagePrices = append(averagePrices, calculateAveragePrice(instrument, window.Name, buckets, bucketsByResolution[buckets], fromTime, toTime))
+	}
+
+	return averagePrices, nil
+}
+
+// calculateAveragePrice calculates VWAP and TWAP over the buckets in [fromTime, toTime). TWAP weights the last price of
+// every bucket, carried over buckets without trades, starting from the last price before the window if it is still kept.
+func calculateAveragePrice(instrument *models.InstrumentModel, window string, buckets priceBuckets, sortedBuckets []*priceBucket, fromTime, toTime int64) *proto.AveragePrice {
+	var quoteVolume, volume, lastPrice, priceTicksSum, pricedBuckets int64
+	resolution := buckets.resolution.Milliseconds()
+	fromTime -= fromTime % resolution
+
+	i := 0
+	for ; i < len(sortedBuckets) && sortedBuckets[i].time < fromTime; i++ {
+		lastPrice = sortedBuckets[i].close
+	}
+	for bucketTime := fromTime; bucketTime < toTime; bucketTime += resolution {
+		if i < len(sortedBuckets) && sortedBuckets[i].time == bucketTime {
+			quoteVolume += sortedBuckets[i].quoteVolume
+			volume += sortedBuckets[i].volume
+			lastPrice = sortedBuckets[i].close
+			i++
+		}
+		if lastPrice > 0 {
+			priceTicksSum += lastPrice
+			pricedBuckets++
+		}
+	}
+
+	averagePrice := &proto.AveragePrice{Window: window, Volume: instrument.VolumeStep.ToFloat(volume)}
+	if volume > 0 {
+		averagePrice.Vwap = instrument.RoundPrice(instrument.PriceTick.Times(instrument.VolumeStep).ToFloat(quoteVolume) / averagePrice.Volume)
+	}
+	if pricedBuckets > 0 {
+		averagePrice.Twap = instrument.RoundPrice(instrument.PriceTick.ToFloat(priceTicksSum) / float64(pricedBuckets))
+	}
+
+	return averagePrice
+}
+
+// getWindowPriceBuckets picks the finest buckets that still keep the whole window.
+func (q *QuoteProcessing) getWindowPriceBuckets(window models.AveragePriceWindowModel) priceBuckets {
+	if window.Session || window.Duration > secondPriceBuckets.resolution*time.Duration(secondPriceBuckets.size-1) {
+		return minutePriceBuckets
+	}
+	return secondPriceBuckets
+}
+
+// getSessionStartTime returns the start in unix milliseconds of the session that contains now.
+func (q *QuoteProcessing) getSessionStartTime(now time.Time) int64 {
+	return now.Add(-q.SessionStart).UTC().Truncate(sessionDuration).Add(q.SessionStart).UnixMilli()
+}
+
+func (b priceBuckets) getBucketTime(t time.Time) int64 {
+	milliseconds := t.UnixMilli()
+	return milliseconds - milliseconds%b.resolution.Milliseconds()
+}
+
+func (b priceBuckets) getSlot(bucketTime int64) int64 {
+	return bucketTime / b.resolution.Milliseconds() % b.size
+}
+
+// parsePriceBuckets parses the ring hash into its buckets ordered by time.
+func parsePriceBuckets(pair string, fields map[string]string) ([]*priceBucket, error) {
+	bucketsBySlot := map[string]*priceBucket{}
+	for field, stringValue := range fields {
+		slot, name, _ := strings.Cut(field, ":")
+		value, err := strconv.ParseInt(stringValue, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(invalidPriceBucketErrMsg, field, pair)
+		}
+
+		bucket, exists := bucketsBySlot[slot]
+		if !exists {
+			bucket = &priceBucket{}
+			bucketsBySlot[slot] = bucket
+		}
+
+		switch name {
+		case "time":
+			bucket.time = value
+		case "quoteVolume":
+			bucket.quoteVolume = value
+		case "volume":
+			bucket.volume = value
+		case "close":
+			bucket.close = value
+		default:
+			return nil, fmt.Errorf(invalidPriceBucketErrMsg, field, pair)
+		}
+	}
+
+	buckets := make([]*priceBucket, 0, len(bucketsBySlot))
+	for _, bucket := range bucketsBySlot {
+		buckets = append(buckets, bucket)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].time < buckets[j].time })
+
+	return buckets, nil
+}
@@ -0,0 +1,69 @@
+package processing
+
+import "testing"
+
+func TestCalculateAveragePrice(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	instrument, _ := q.Instruments.Get(testPair)
+	// The window holds the five second buckets in [10000, 15000).
+	fromTime, toTime := int64(10000), int64(15000)
+	trade := func(bucketTime, priceTicks, volumeSteps int64) *priceBucket {
+		return &priceBucket{time: bucketTime, quoteVolume: priceTicks * volumeSteps, volume: volumeSteps, close: priceTicks}
+	}
+
+	tests := []struct {
+		name          string
+		sortedBuckets []*priceBucket
+		wantVwap      float64
+		wantTwap      float64
+		wantVolume    float64
+	}{
+		{
+			name:          "TWAP carries the last price over empty buckets",
+			sortedBuckets: []*priceBucket{trade(10000, 10000, 100), trade(13000, 10100, 100)},
+			wantVwap:      1.005,
+			wantTwap:      1.004,
+			wantVolume:    2,
+		},
+		{
+			name:          "TWAP starts from the last price before the window",
+			sortedBuckets: []*priceBucket{trade(8000, 9900, 100), trade(12000, 10000, 100)},
+			wantVwap:      1,
+			wantTwap:      0.996,
+			wantVolume:    1,
+		},
+		{
+			name:          "TWAP weighs only the buckets after the first price",
+			sortedBuckets: []*priceBucket{trade(12000, 10000, 100), trade(14000, 10300, 100)},
+			wantVwap:      1.015,
+			wantTwap:      1.01,
+			wantVolume:    2,
+		},
+		{
+			name: "VWAP leaves out the buckets outside the window",
+			sortedBuckets: []*priceBucket{trade(5000, 20000, 500), trade(9000, 20000, 500), trade(10000, 10000, 100),
+				trade(14000, 10200, 300), trade(15000, 30000, 500)},
+			wantVwap:   1.015,
+			wantTwap:   1.004,
+			wantVolume: 4,
+		},
+		{
+			name:          "window without trades keeps only the TWAP",
+			sortedBuckets: []*priceBucket{trade(9000, 10000, 100)},
+			wantTwap:      1,
+		},
+		{
+			name: "no trades",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			averagePrice := calculateAveragePrice(instrument, "5s", secondPriceBuckets, tt.sortedBuckets, fromTime, toTime)
+			if averagePrice.Vwap != tt.wantVwap || averagePrice.Twap != tt.wantTwap || averagePrice.Volume != tt.wantVolume {
+				t.Errorf("calculateAveragePrice() = vwap %v, twap %v, volume %v, want %v, %v, %v",
+					averagePrice.Vwap, averagePrice.Twap, averagePrice.Volume, tt.wantVwap, tt.wantTwap, tt.wantVolume)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package processing
+
+import (
+	"QuoteService/models"
+	"QuoteService/proto"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	candleKey          = "candle:%s:%s:%v"
+	candleIndexKey     = "candles:%s:%s"
+	candlePublishedKey = "candles:%s:%s:published"
+	candleAppliedKey   = "candles:%s:applied:%s"
+
+	// candleIntervals lists every interval candles are built for, shortest first.
+	candleIntervals = []proto.CandleInterval{
+		proto.CandleInterval_MINUTE_1,
+		proto.CandleInterval_MINUTE_5,
+		proto.CandleInterval_MINUTE_15,
+		proto.CandleInterval_HOUR_1,
+		proto.CandleInterval_DAY_1,
+	}
+	candleIntervalDurations = map[proto.CandleInterval]time.Duration{
+		proto.CandleInterval_MINUTE_1:  time.Minute,
+		proto.CandleInterval_MINUTE_5:  5 * time.Minute,
+		proto.CandleInterval_MINUTE_15: 15 * time.Minute,
+		proto.CandleInterval_HOUR_1:    time.Hour,
+		proto.CandleInterval_DAY_1:     24 * time.Hour,
+	}
+	// candleRetentions is how long candles of every interval are kept after they open.
+	candleRetentions = map[proto.CandleInterval]time.Duration{
+		proto.CandleInterval_MINUTE_1:  7 * 24 * time.Hour,
+		proto.CandleInterval_MINUTE_5:  30 * 24 * time.Hour,
+		proto.CandleInterval_MINUTE_15: 90 * 24 * time.Hour,
+		proto.CandleInterval_HOUR_1:    365 * 24 * time.Hour,
+		proto.CandleInterval_DAY_1:     5 * 365 * 24 * time.Hour,
+	}
+
+	// candlePublishDelay is how long after a candle closed it is published, so trades delayed by retries still land in it.
+	// A trade processed later is added to the candle, but the candle is not published again.
+	candlePublishDelay = 30 * time.Second
+
+	defaultCandlesPageSize = 500
+	maxCandlesPageSize     = 1000
+
+	unknownCandleIntervalErrMsg = "unknown candle interval: %d"
+	invalidCandlesRangeErrMsg   = "invalid candles range: from %d to %d"
+	invalidCandlesLimitErrMsg   = "invalid candles limit: %d"
+	notFoundCandleErrMsg        = "Not found candle %s for pair: %s at: %d"
+	invalidCandleErrMsg         = "invalid candle for pair: %s at: %d, field: %s"
+)
+
+// updateCandlesScript adds a trade of ARGV[2] price ticks, ARGV[3] volume steps and ARGV[4] quote volume (ticks times steps)
+// to the candle of every interval. The trade is remembered in KEYS[1] for ARGV[1] seconds, a trade already remembered
+// there is not added again and replies 0. The other KEYS come in pairs of the candle hash and the candle index of an
+// interval and ARGV[2 + 3 * i] to ARGV[4 + 3 * i] are the open time of the i-th candle, the unix millisecond it expires at
+// and the open time older candles are removed from the index before. Both are applied once, when the candle opens.
+var updateCandlesScript = redis.NewScript(`
+if not redis.call('SET', KEYS[1], 1, 'NX', 'EX', ARGV[1]) then
+	return 0
+end
+local price = tonumber(ARGV[2])
+for i = 1, (#KEYS - 1) / 2 do
+	local candleKey, indexKey = KEYS[2 * i], KEYS[2 * i + 1]
+	local openTime, expireAt, trimBefore = ARGV[2 + 3 * i], ARGV[3 + 3 * i], ARGV[4 + 3 * i]
+	local high = redis.call('HGET', candleKey, 'high')
+	if not high then
+		redis.call('HSET', candleKey, 'open', ARGV[2], 'high', ARGV[2], 'low', ARGV[2])
+		redis.call('PEXPIREAT', candleKey, expireAt)
+		redis.call('ZADD', indexKey, openTime, openTime)
+		redis.call('ZREMRANGEBYSCORE', indexKey, '-inf', '(' .. trimBefore)
+	else
+		if price > tonumber(high) then
+			redis.call('HSET', candleKey, 'high', ARGV[2])
+		end
+		if price < tonumber(redis.call('HGET', candleKey, 'low')) then
+			redis.call('HSET', candleKey, 'low', ARGV[2])
+		end
+	end
+	redis.call('HSET', candleKey, 'close', ARGV[2])
+	redis.call('HINCRBY', candleKey, 'volume', ARGV[3])
+	redis.call('HINCRBY', candleKey, 'quoteVolume', ARGV[4])
+	redis.call('HINCRBY', candleKey, 'trades', 1)
+end
+return 1
+`)
+
+// claimClosedCandlesScript returns the open times from the candle index (KEYS[1]) of candles that opened
+// at or before ARGV[1] and were not claimed yet, and remembers the latest of them in KEYS[2], so every
+// closed candle is claimed for publishing exactly once even with several replicas running.
+var claimClosedCandlesScript = redis.NewScript(`
+local published = redis.call('GET', KEYS[2])
+local from = '-inf'
+if published then
+	from = '(' .. published
+end
+local openTimes = redis.call('ZRANGEBYSCORE', KEYS[1], from, ARGV[1])
+if #openTimes > 0 then
+	redis.call('SET', KEYS[2], openTimes[#openTimes])
+end
+return openTimes
+`)
+
+// UpdateCandles adds a matched trade to the current candle of the pair for every candle interval.
+// Candles are aligned to the interval in UTC by tradeTime and expire after the retention of their interval,
+// a trade older than the retention is not added to the candles of that interval.
+// matchId names the match, a match with the same id is added only once.
+func (q *QuoteProcessing) UpdateCandles(matchId string, pair *proto.OrderPair, price, volume float64, tradeTime time.Time) error {
+	stringPair := q.Instruments.Name(*pair)
+	instrument, err := q.getInstrument(stringPair)
+	if err != nil {
+		return err
+	}
+	priceTicks, err := getPriceTicks(instrument, price)
+	if err != nil {
+		return err
+	}
+
+	volumeSteps, err := getVolumeSteps(instrument, volume)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UnixMilli()
+	keys := make([]string, 0, 1+2*len(candleIntervals))
+	keys = append(keys, fmt.Sprintf(candleAppliedKey, stringPair, matchId))
+	args := []interface{}{int64(tradeRecordedTtl.Seconds()), priceTicks, volumeSteps, priceTicks * volumeSteps}
+	for _, interval := range candleIntervals {
+		openTime := getCandleOpenTime(interval, tradeTime)
+		retention := candleRetentions[interval].Milliseconds()
+		if openTime+retention <= now {
+			continue
+		}
+		keys = append(keys, fmt.Sprintf(candleKey, stringPair, interval.String(), openTime), fmt.Sprintf(candleIndexKey, stringPair, interval.String()))
+		args = append(args, openTime, openTime+retention, openTime-retention)
+	}
+
+	return updateCandlesScript.Run(context.Background(), q.RedisClient, keys, args...).Err()
+}
+
+// GetClosedCandleEvents returns CandleEvent for every candle of the active pairs that closed candlePublishDelay
+// before now and was not returned before.
+func (q *QuoteProcessing) GetClosedCandleEvents(now time.Time) ([]*proto.CandleEvent, error) {
+	var candleEvents []*proto.CandleEvent
+	for _, stringPair := range q.Instruments.Pairs() {
+		instrument, err := q.getInstrument(stringPair)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, interval := range candleIntervals {
+			indexKey := fmt.Sprintf(candleIndexKey, stringPair, interval.String())
+			publishedKey := fmt.Sprintf(candlePublishedKey, stringPair, interval.String())
+			lastClosedOpenTime := getCandleOpenTime(interval, now.Add(-candlePublishDelay)) - candleIntervalDurations[interval].Milliseconds()
+
+			openTimes, err := claimClosedCandlesScript.Run(context.Background(), q.RedisClient, []string{indexKey, publishedKey}, lastClosedOpenTime).Int64Slice()
+			if err != nil {
+				return nil, err
+			}
+
+			for _, openTime := range openTimes {
+				candle, err := q.getCandle(instrument, interval, openTime)
+				if err != nil {
+					return nil, err
+				}
+				candleEvents = append(candleEvents, &proto.CandleEvent{Pair: instrument.Id, Interval: interval, Candle: candle})
+			}
+		}
+	}
+
+	return candleEvents, nil
+}
+
+// GetCandles returns up to limit candles of the pair and interval with open time in [fromTime, toTime),
+// oldest first, including the candle that is still open. When the range has more candles, the open time
+// of the next one is returned to request the following page from.
+func (q *QuoteProcessing) GetCandles(pair string, interval proto.CandleInterval, fromTime, toTime int64, limit int) ([]*proto.Candle, int64, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, 0, err
+	}
+	duration, exists := candleIntervalDurations[interval]
+	if !exists {
+		return nil, 0, invalidRequestError(unknownCandleIntervalErrMsg, interval)
+	}
+	if fromTime < 0 || toTime <= fromTime {
+		return nil, 0, invalidRequestError(invalidCandlesRangeErrMsg, fromTime, toTime)
+	}
+	switch {
+	case limit < 0:
+		return nil, 0, invalidRequestError(invalidCandlesLimitErrMsg, limit)
+	case limit == 0:
+		limit = defaultCandlesPageSize
+	case limit > maxCandlesPageSize:
+		limit = maxCandlesPageSize
+	}
+
+	storedCandles, err := q.getStoredCandles(instrument, interval, fromTime, toTime, int64(limit)+1)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var nextFromTime int64
+	if len(storedCandles) > limit {
+		nextFromTime = storedCandles[limit].openTime
+		storedCandles = storedCandles[:limit]
+	}
+
+	candles := make([]*proto.Candle, 0, len(storedCandles))
+	for _, storedCandle := range storedCandles {
+		candles = append(candles, storedCandle.toCandle(instrument, duration))
+	}
+
+	return candles, nextFromTime, nil
+}
+
+// storedCandle is a candle as kept in redis, with prices in ticks, volume in steps and quote volume in ticks times steps.
+type storedCandle struct {
+	openTime                    int64
+	open, high, low, close      int64
+	volume, quoteVolume, trades int64
+}
+
+func (c *storedCandle) toCandle(instrument *models.InstrumentModel, duration time.Duration) *proto.Candle {
+	return &proto.Candle{
+		OpenTime:    c.openTime,
+		CloseTime:   c.openTime + duration.Milliseconds(),
+		Open:        instrument.PriceTick.ToFloat(c.open),
+		High:        instrument.PriceTick.ToFloat(c.high),
+		Low:         instrument.PriceTick.ToFloat(c.low),
+		Close:       instrument.PriceTick.ToFloat(c.close),
+		Volume:      instrument.VolumeStep.ToFloat(c.volume),
+		QuoteVolume: instrument.PriceTick.Times(instrument.VolumeStep).ToFloat(c.quoteVolume),
+		TradeCount:  uint64(c.trades),
+	}
+}
+
+// getStoredCandles returns up to count candles of the pair and interval with open time in [fromTime, toTime), oldest first.
+func (q *QuoteProcessing) getStoredCandles(instrument *models.InstrumentModel, interval proto.CandleInterval, fromTime, toTime, count int64) ([]*storedCandle, error) {
+	openTimes, err := q.RedisClient.ZRangeByScore(context.Background(), fmt.Sprintf(candleIndexKey, instrument.Pair, interval.String()), &redis.ZRangeBy{
+		Min:   strconv.FormatInt(fromTime, 10),
+		Max:   "(" + strconv.FormatInt(toTime, 10),
+		Count: count,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	candleCmds := make([]*redis.MapStringStringCmd, len(openTimes))
+	_, err = q.RedisClient.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		for i, openTime := range openTimes {
+			candleCmds[i] = pipe.HGetAll(context.Background(), fmt.Sprintf(candleKey, instrument.Pair, interval.String(), openTime))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	storedCandles := make([]*storedCandle, 0, len(openTimes))
+	for i, stringOpenTime := range openTimes {
+		openTime, err := strconv.ParseInt(stringOpenTime, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		storedCandle, err := parseStoredCandle(instrument, openTime, candleCmds[i].Val())
+		if err != nil {
+			return nil, err
+		}
+		storedCandles = append(storedCandles, storedCandle)
+	}
+
+	return storedCandles, nil
+}
+
+func (q *QuoteProcessing) getCandle(instrument *models.InstrumentModel, interval proto.CandleInterval, openTime int64) (*proto.Candle, error) {
+	duration, exists := candleIntervalDurations[interval]
+	if !exists {
+		return nil, fmt.Errorf(unknownCandleIntervalErrMsg, interval)
+	}
+
+	fields, err := q.RedisClient.HGetAll(context.Background(), fmt.Sprintf(candleKey, instrument.Pair, interval.String(), openTime)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf(notFoundCandleErrMsg, interval.String(), instrument.Pair, openTime)
+	}
+
+	storedCandle, err := parseStoredCandle(instrument, openTime, fields)
+	if err != nil {
+		return nil, err
+	}
+	return storedCandle.toCandle(instrument, duration), nil
+}
+
+func parseStoredCandle(instrument *models.InstrumentModel, openTime int64, fields map[string]string) (*storedCandle, error) {
+	candle := &storedCandle{openTime: openTime}
+	values := map[string]*int64{
+		"open": &candle.open, "high": &candle.high, "low": &candle.low, "close": &candle.close,
+		"volume": &candle.volume, "quoteVolume": &candle.quoteVolume, "trades": &candle.trades,
+	}
+	for field, value := range values {
+		var err error
+		if *value, err = strconv.ParseInt(fields[field], 10, 64); err != nil {
+			return nil, fmt.Errorf(invalidCandleErrMsg, instrument.Pair, openTime, field)
+		}
+	}
+
+	return candle, nil
+}
+
+// getCandleOpenTime returns the open time in unix milliseconds of the interval candle containing t.
+func getCandleOpenTime(interval proto.CandleInterval, t time.Time) int64 {
+	intervalMilliseconds := candleIntervalDurations[interval].Milliseconds()
+	milliseconds := t.UnixMilli()
+	return milliseconds - milliseconds%intervalMilliseconds
+}
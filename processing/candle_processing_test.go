@@ -0,0 +1,107 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestUpdateCandlesRetention(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	pair := proto.OrderPair_USD_EUR
+	now := time.Now()
+
+	for _, tradeTime := range []time.Time{now.Add(-8 * 24 * time.Hour), now} {
+		if err := q.UpdateCandles(nextUpdateId(), &pair, 1.0001, 1, tradeTime); err != nil {
+			t.Fatalf("UpdateCandles() error = %v", err)
+		}
+	}
+
+	for _, interval := range candleIntervals {
+		t.Run(interval.String(), func(t *testing.T) {
+			openTime := getCandleOpenTime(interval, now)
+			ttl, err := q.RedisClient.PTTL(context.Background(), fmt.Sprintf(candleKey, testPair, interval.String(), openTime)).Result()
+			if err != nil {
+				t.Fatalf("PTTL() error = %v", err)
+			}
+			if retention := candleRetentions[interval]; ttl <= 0 || ttl > retention {
+				t.Errorf("candle ttl = %v, want in (0, %v]", ttl, retention)
+			}
+
+			// Only the minute candle of 8 days ago is past its retention, the other intervals keep both.
+			wantCandles := int64(2)
+			if interval == proto.CandleInterval_MINUTE_1 {
+				wantCandles = 1
+			}
+			candles, err := q.RedisClient.ZCard(context.Background(), fmt.Sprintf(candleIndexKey, testPair, interval.String())).Result()
+			if err != nil {
+				t.Fatalf("ZCard() error = %v", err)
+			}
+			if candles != wantCandles {
+				t.Errorf("indexed candles = %d, want %d", candles, wantCandles)
+			}
+		})
+	}
+}
+
+func TestUpdateCandlesAddsMatchOnce(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	pair := proto.OrderPair_USD_EUR
+	tradeTime := time.Now()
+
+	for _, matchId := range []string{"book:1", "book:1", "book:2"} {
+		if err := q.UpdateCandles(matchId, &pair, 1.0001, 1, tradeTime); err != nil {
+			t.Fatalf("UpdateCandles(%s) error = %v", matchId, err)
+		}
+	}
+
+	for _, interval := range candleIntervals {
+		instrument, _ := q.Instruments.Get(testPair)
+		candle, err := q.getCandle(instrument, interval, getCandleOpenTime(interval, tradeTime))
+		if err != nil {
+			t.Fatalf("getCandle() error = %v", err)
+		}
+		if candle.TradeCount != 2 || candle.Volume != 2 {
+			t.Errorf("%s candle = %d trades of %v, want 2 trades of 2", interval, candle.TradeCount, candle.Volume)
+		}
+	}
+}
+
+func TestGetClosedCandleEventsWaitsPublishDelay(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	pair := proto.OrderPair_USD_EUR
+	tradeTime := time.Now()
+	if err := q.UpdateCandles(nextUpdateId(), &pair, 1.0001, 1, tradeTime); err != nil {
+		t.Fatalf("UpdateCandles() error = %v", err)
+	}
+	closeTime := time.UnixMilli(getCandleOpenTime(proto.CandleInterval_MINUTE_1, tradeTime)).Add(time.Minute)
+
+	tests := []struct {
+		now         time.Time
+		wantCandles int
+	}{
+		{now: closeTime, wantCandles: 0},
+		{now: closeTime.Add(candlePublishDelay - time.Millisecond), wantCandles: 0},
+		{now: closeTime.Add(candlePublishDelay), wantCandles: 1},
+		{now: closeTime.Add(2 * candlePublishDelay), wantCandles: 0},
+	}
+
+	for _, tt := range tests {
+		candleEvents, err := q.GetClosedCandleEvents(tt.now)
+		if err != nil {
+			t.Fatalf("GetClosedCandleEvents() error = %v", err)
+		}
+
+		minuteCandles := 0
+		for _, candleEvent := range candleEvents {
+			if candleEvent.Interval == proto.CandleInterval_MINUTE_1 {
+				minuteCandles++
+			}
+		}
+		if minuteCandles != tt.wantCandles {
+			t.Errorf("GetClosedCandleEvents(close + %v) = %d minute candles, want %d", tt.now.Sub(closeTime), minuteCandles, tt.wantCandles)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package processing
+
+import (
+	"QuoteService/models"
+	"QuoteService/proto"
+)
+
+// crossRateLeg is a pair used to go from one currency to another, inverted when
+// the pair quotes them the other way round.
+type crossRateLeg struct {
+	instrument *models.InstrumentModel
+	inverted   bool
+}
+
+// crossRate implies the rate of instrument by going from its base currency to viaCurrency and on to its quote currency.
+type crossRate struct {
+	instrument  *models.InstrumentModel
+	viaCurrency string
+	legs        [2]crossRateLeg
+}
+
+// pairRates are the last trade price and the top of book of a pair, 0 when missing.
+type pairRates struct {
+	last, bid, ask float64
+}
+
+// getCrossRates returns every way the active pairs imply each other through a third currency.
+func (q *QuoteProcessing) getCrossRates() []crossRate {
+	var instruments []*models.InstrumentModel
+	for _, stringPair := range q.Instruments.Pairs() {
+		if instrument, exists := q.Instruments.Get(stringPair); exists {
+			instruments = append(instruments, instrument)
+		}
+	}
+
+	var crossRates []crossRate
+	for _, target := range instruments {
+		for _, first := range instruments {
+			firstLeg, viaCurrency, ok := getCrossRateLeg(first, target.BaseCurrency)
+			if first == target || !ok || viaCurrency == target.QuoteCurrency {
+				continue
+			}
+
+			for _, second := range instruments {
+				secondLeg, quoteCurrency, ok := getCrossRateLeg(second, viaCurrency)
+				if second == target || second == first || !ok || quoteCurrency != target.QuoteCurrency {
+					continue
+				}
+
+				crossRates = append(crossRates, crossRate{instrument: target, viaCurrency: viaCurrency, legs: [2]crossRateLeg{firstLeg, secondLeg}})
+			}
+		}
+	}
+
+	return crossRates
+}
+
+// getCrossRateLeg returns the leg going from currency to the other currency of the instrument.
+func getCrossRateLeg(instrument *models.InstrumentModel, currency string) (crossRateLeg, string, bool) {
+	switch currency {
+	case instrument.BaseCurrency:
+		return crossRateLeg{instrument: instrument}, instrument.QuoteCurrency, true
+	case instrument.QuoteCurrency:
+		return crossRateLeg{instrument: instrument, inverted: true}, instrument.BaseCurrency, true
+	default:
+		return crossRateLeg{}, "", false
+	}
+}
+
+// getPairRates reads the last trade price and the top of book of every active pair.
+func (q *QuoteProcessing) getPairRates() (map[string]pairRates, error) {
+	ratesByPair := map[string]pairRates{}
+	for _, stringPair := range q.Instruments.Pairs() {
+		lastQuote, err := q.getQuote(stringPair)
+		if err != nil {
+			return nil, err
+		}
+		topOfBookEvent, err := q.GetTopOfBookEvent(stringPair)
+		if err != nil {
+			return nil, err
+		}
+
+		rates := pairRates{last: lastQuote.Price}
+		if topOfBookEvent.BestBid != nil {
+			rates.bid = topOfBookEvent.BestBid.Price
+		}
+		if topOfBookEvent.BestAsk != nil {
+			rates.ask = topOfBookEvent.BestAsk.Price
+		}
+		ratesByPair[stringPair] = rates
+	}
+
+	return ratesByPair, nil
+}
+
+// implyRates multiplies the rates of the legs, inverted legs swap bid and ask.
+func (c crossRate) implyRates(ratesByPair map[string]pairRates) pairRates {
+	implied := pairRates{last: 1, bid: 1, ask: 1}
+	for _, leg := range c.legs {
+		rates := ratesByPair[leg.instrument.Pair]
+		if leg.inverted {
+			rates = pairRates{last: invert(rates.last), bid: invert(rates.ask), ask: invert(rates.bid)}
+		}
+
+		implied.last *= rates.last
+		implied.bid *= rates.bid
+		implied.ask *= rates.ask
+	}
+
+	return implied
+}
+
+// getCrossRateQuotes returns the synthetic quotes implied from the last trades of the legs
+// and the deviations of the real rates from the implied ones.
+func (q *QuoteProcessing) getCrossRateQuotes() ([]*proto.PairQuote, []*proto.CrossRateDeviation, error) {
+	ratesByPair, err := q.getPairRates()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var syntheticQuotes []*proto.PairQuote
+	var deviations []*proto.CrossRateDeviation
+	for _, crossRate := range q.getCrossRates() {
+		instrument := crossRate.instrument
+		realRates, implied := ratesByPair[instrument.Pair], crossRate.implyRates(ratesByPair)
+
+		if implied.last != 0 {
+			syntheticQuotes = append(syntheticQuotes, &proto.PairQuote{
+				Pair:        instrument.Id,
+				Price:       instrument.RoundPrice(implied.last),
+				Synthetic:   true,
+				ViaCurrency: crossRate.viaCurrency,
+			})
+		}
+
+		// Deviations are calculated from the implied rates before they are rounded to the precision of the pair.
+		var midPrice, impliedMidPrice float64
+		if realRates.bid != 0 && realRates.ask != 0 {
+			midPrice = (realRates.bid + realRates.ask) / 2
+		}
+		if implied.bid != 0 && implied.ask != 0 {
+			impliedMidPrice = (implied.bid + implied.ask) / 2
+		}
+		deviations = append(deviations, &proto.CrossRateDeviation{
+			Pair:                      instrument.Id,
+			ViaCurrency:               crossRate.viaCurrency,
+			LastPrice:                 realRates.last,
+			ImpliedLastPrice:          instrument.RoundPrice(implied.last),
+			ImpliedBid:                instrument.RoundPrice(implied.bid),
+			ImpliedAsk:                instrument.RoundPrice(implied.ask),
+			MidPrice:                  midPrice,
+			ImpliedMidPrice:           instrument.RoundPrice(impliedMidPrice),
+			LastPriceDeviationPercent: getDeviationPercent(realRates.last, implied.last),
+			MidPriceDeviationPercent:  getDeviationPercent(midPrice, impliedMidPrice),
+		})
+
+	}
+
+	return syntheticQuotes, deviations, nil
+}
+
+func getDeviationPercent(price, impliedPrice float64) float64 {
+	if price == 0 || impliedPrice == 0 {
+		return 0
+	}
+	return (price - impliedPrice) / impliedPrice * 100
+}
+
+func invert(rate float64) float64 {
+	if rate == 0 {
+		return 0
+	}
+	return 1 / rate
+}
@@ -0,0 +1,92 @@
+package processing
+
+import "testing"
+
+func TestGetCrossRates(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+
+	crossRates := q.getCrossRates()
+	// Each of the three pairs is implied by the other two.
+	if len(crossRates) != 3 {
+		t.Fatalf("len(getCrossRates()) = %d, want 3", len(crossRates))
+	}
+	for _, crossRate := range crossRates {
+		for _, leg := range crossRate.legs {
+			if leg.instrument == crossRate.instrument {
+				t.Errorf("%s is implied from itself", crossRate.instrument.Pair)
+			}
+		}
+	}
+}
+
+func TestImplyRates(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	crossRateByPair := map[string]crossRate{}
+	for _, crossRate := range q.getCrossRates() {
+		crossRateByPair[crossRate.instrument.Pair] = crossRate
+	}
+
+	tests := []struct {
+		name         string
+		pair         string
+		ratesByPair  map[string]pairRates
+		wantInverted [2]bool
+		want         pairRates
+	}{
+		{
+			name: "legs quoted the same way multiply",
+			pair: "USD_EUR",
+			ratesByPair: map[string]pairRates{
+				"USD_UAH": {last: 32, bid: 32, ask: 64},
+				"UAH_EUR": {last: 1.0 / 64, bid: 1.0 / 128, ask: 1.0 / 32},
+			},
+			want: pairRates{last: 0.5, bid: 0.25, ask: 2},
+		},
+		{
+			name: "inverted second leg swaps and inverts its bid and ask",
+			pair: "USD_UAH",
+			ratesByPair: map[string]pairRates{
+				"USD_EUR": {last: 0.5, bid: 0.25, ask: 1},
+				"UAH_EUR": {last: 1.0 / 64, bid: 1.0 / 128, ask: 1.0 / 32},
+			},
+			wantInverted: [2]bool{false, true},
+			want:         pairRates{last: 32, bid: 8, ask: 128},
+		},
+		{
+			name: "inverted first leg swaps and inverts its bid and ask",
+			pair: "UAH_EUR",
+			ratesByPair: map[string]pairRates{
+				"USD_UAH": {last: 32, bid: 32, ask: 64},
+				"USD_EUR": {last: 0.5, bid: 0.25, ask: 1},
+			},
+			wantInverted: [2]bool{true, false},
+			want:         pairRates{last: 1.0 / 64, bid: 1.0 / 256, ask: 1.0 / 32},
+		},
+		{
+			name: "missing rate of a leg is not implied",
+			pair: "USD_UAH",
+			ratesByPair: map[string]pairRates{
+				"USD_EUR": {last: 0.5, bid: 0.25, ask: 1},
+				"UAH_EUR": {last: 1.0 / 64, ask: 1.0 / 32},
+			},
+			wantInverted: [2]bool{false, true},
+			want:         pairRates{last: 32, bid: 8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crossRate, exists := crossRateByPair[tt.pair]
+			if !exists {
+				t.Fatalf("no cross rate for %s", tt.pair)
+			}
+			if gotInverted := [2]bool{crossRate.legs[0].inverted, crossRate.legs[1].inverted}; gotInverted != tt.wantInverted {
+				t.Fatalf("inverted legs = %v, want %v", gotInverted, tt.wantInverted)
+			}
+
+			if got := crossRate.implyRates(tt.ratesByPair); got != tt.want {
+				t.Errorf("implyRates() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
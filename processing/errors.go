@@ -0,0 +1,14 @@
+package processing
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidRequest is wrapped by errors caused by the request itself, such as an unknown pair or
+// a bad time range, so request/reply handlers can answer them with ERROR_INVALID_REQUEST.
+var ErrInvalidRequest = errors.New("invalid request")
+
+func invalidRequestError(format string, a ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRequest, fmt.Sprintf(format, a...))
+}
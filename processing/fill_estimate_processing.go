@@ -0,0 +1,108 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	invalidFillVolumeErrMsg = "invalid volume: %v for pair: %s"
+)
+
+// EstimateFill walks the current book of the pair against a MARKET order of direction and volume
+// and returns the prices it would fill at.
+func (q *QuoteProcessing) EstimateFill(pair string, direction proto.OrderDirection, volume float64) (*proto.EstimateFillResponse, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := proto.OrderDirection_name[int32(direction)]; !exists {
+		return nil, invalidRequestError(invalidOrderDirectionErrMsg, direction.String())
+	}
+	volumeSteps, err := getVolumeSteps(instrument, volume)
+	if err != nil {
+		return nil, err
+	}
+	if volumeSteps < 0 {
+		return nil, invalidRequestError(invalidFillVolumeErrMsg, volume, pair)
+	}
+
+	bookDirection, oppositeDirection := proto.OrderDirection_SELL, proto.OrderDirection_BUY
+	if direction == proto.OrderDirection_SELL {
+		bookDirection, oppositeDirection = proto.OrderDirection_BUY, proto.OrderDirection_SELL
+	}
+
+	// The book side and the best price of the other side are read in one MULTI, so the mid price matches the book.
+	var bookPricesCmd, oppositeBestPriceCmd *redis.StringSliceCmd
+	var bookVolumesCmd *redis.MapStringStringCmd
+	_, err = q.RedisClient.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		bookPricesCmd = rangePrices(pipe, bookDirection.String(), pair, -1)
+		bookVolumesCmd = pipe.HGetAll(context.Background(), fmt.Sprintf(marketDepthVolumesKey, bookDirection.String(), pair))
+		oppositeBestPriceCmd = rangePrices(pipe, oppositeDirection.String(), pair, 0)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	estimateFillResponse := &proto.EstimateFillResponse{Pair: instrument.Id, Direction: direction}
+
+	bookPrices, bookVolumes := bookPricesCmd.Val(), bookVolumesCmd.Val()
+	if oppositeBestPrice := oppositeBestPriceCmd.Val(); len(bookPrices) != 0 && len(oppositeBestPrice) != 0 {
+		bookBestTicks, err := strconv.ParseInt(bookPrices[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		oppositeBestTicks, err := strconv.ParseInt(oppositeBestPrice[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		estimateFillResponse.MidPrice = instrument.PriceTick.Half().ToFloat(bookBestTicks + oppositeBestTicks)
+	}
+
+	var filledSteps, costUnits, worstPriceTicks int64
+	for _, stringPriceTicks := range bookPrices {
+		if filledSteps == volumeSteps {
+			break
+		}
+
+		priceTicks, err := strconv.ParseInt(stringPriceTicks, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		bookLevelSteps, err := strconv.ParseInt(bookVolumes[stringPriceTicks], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		levelSteps := min(bookLevelSteps, volumeSteps-filledSteps)
+
+		filledSteps += levelSteps
+		costUnits += priceTicks * levelSteps
+		worstPriceTicks = priceTicks
+		estimateFillResponse.LevelsConsumed++
+	}
+
+	estimateFillResponse.FilledVolume = instrument.VolumeStep.ToFloat(filledSteps)
+	estimateFillResponse.UnfilledVolume = instrument.VolumeStep.ToFloat(volumeSteps - filledSteps)
+	if filledSteps == 0 {
+		return estimateFillResponse, nil
+	}
+
+	estimateFillResponse.Cost = instrument.PriceTick.Times(instrument.VolumeStep).ToFloat(costUnits)
+	averagePrice := estimateFillResponse.Cost / estimateFillResponse.FilledVolume
+	estimateFillResponse.AveragePrice = instrument.RoundPrice(averagePrice)
+	estimateFillResponse.WorstPrice = instrument.PriceTick.ToFloat(worstPriceTicks)
+	if estimateFillResponse.MidPrice != 0 {
+		priceImpact := averagePrice - estimateFillResponse.MidPrice
+		if direction == proto.OrderDirection_SELL {
+			priceImpact = -priceImpact
+		}
+		estimateFillResponse.PriceImpactPercent = priceImpact / estimateFillResponse.MidPrice * 100
+	}
+
+	return estimateFillResponse, nil
+}
@@ -0,0 +1,48 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"testing"
+)
+
+func TestEstimateFill(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	levels := []struct {
+		direction     proto.OrderDirection
+		price, volume float64
+	}{
+		{direction: proto.OrderDirection_BUY, price: 0.9998, volume: 1},
+		{direction: proto.OrderDirection_SELL, price: 1.0002, volume: 1},
+		{direction: proto.OrderDirection_SELL, price: 1.0004, volume: 2},
+	}
+	for _, level := range levels {
+		if _, _, err := q.UpdateMarketDepth(nextUpdateId(), level.direction.String(), testPair, level.price, level.volume); err != nil {
+			t.Fatalf("UpdateMarketDepth() error = %v", err)
+		}
+	}
+
+	estimateFillResponse, err := q.EstimateFill(testPair, proto.OrderDirection_BUY, 2)
+	if err != nil {
+		t.Fatalf("EstimateFill() error = %v", err)
+	}
+
+	if estimateFillResponse.MidPrice != 1 {
+		t.Errorf("MidPrice = %v, want 1", estimateFillResponse.MidPrice)
+	}
+	if estimateFillResponse.FilledVolume != 2 || estimateFillResponse.UnfilledVolume != 0 || estimateFillResponse.LevelsConsumed != 2 {
+		t.Errorf("filled %v, unfilled %v over %d levels, want 2, 0 over 2 levels",
+			estimateFillResponse.FilledVolume, estimateFillResponse.UnfilledVolume, estimateFillResponse.LevelsConsumed)
+	}
+	if estimateFillResponse.Cost != 2.0006 || estimateFillResponse.WorstPrice != 1.0004 {
+		t.Errorf("cost %v, worst price %v, want 2.0006, 1.0004", estimateFillResponse.Cost, estimateFillResponse.WorstPrice)
+	}
+
+	estimateFillResponse, err = q.EstimateFill(testPair, proto.OrderDirection_SELL, 3)
+	if err != nil {
+		t.Fatalf("EstimateFill() error = %v", err)
+	}
+	if estimateFillResponse.FilledVolume != 1 || estimateFillResponse.UnfilledVolume != 2 || estimateFillResponse.WorstPrice != 0.9998 {
+		t.Errorf("filled %v, unfilled %v, worst price %v, want 1, 2, 0.9998",
+			estimateFillResponse.FilledVolume, estimateFillResponse.UnfilledVolume, estimateFillResponse.WorstPrice)
+	}
+}
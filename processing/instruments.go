@@ -0,0 +1,53 @@
+package processing
+
+import "QuoteService/models"
+
+var (
+	unknownInstrumentErrMsg  = "no instrument configured for pair: %s"
+	disabledInstrumentErrMsg = "instrument for pair: %s is disabled"
+	invalidPriceErrMsg       = "invalid price: %v for pair: %s"
+	invalidVolumeErrMsg      = "invalid volume: %v for pair: %s, it is not a whole number of volume steps %s"
+)
+
+// getInstrument returns the instrument of an active pair, updates and events of unknown or disabled pairs are rejected
+// as invalid requests, so listeners dead letter them instead of retrying.
+func (q *QuoteProcessing) getInstrument(pair string) (*models.InstrumentModel, error) {
+	instrument, exists := q.Instruments.Get(pair)
+	if !exists {
+		return nil, invalidRequestError(unknownInstrumentErrMsg, pair)
+	}
+	if !instrument.Enabled {
+		return nil, invalidRequestError(disabledInstrumentErrMsg, pair)
+	}
+	return instrument, nil
+}
+
+// getPriceTicks rounds an incoming price to the price tick of the instrument
+// and rejects prices that do not round to at least one tick.
+func getPriceTicks(instrument *models.InstrumentModel, price float64) (int64, error) {
+	priceTicks := instrument.PriceTick.ToSteps(price)
+	if priceTicks <= 0 {
+		return 0, invalidRequestError(invalidPriceErrMsg, price, instrument.Pair)
+	}
+	return priceTicks, nil
+}
+
+// getVolumeSteps counts an incoming volume in volume steps of the instrument. Volumes that are not a
+// non-zero whole number of steps are rejected instead of rounded, so no update is applied partially.
+func getVolumeSteps(instrument *models.InstrumentModel, volume float64) (int64, error) {
+	volumeSteps, exact := instrument.VolumeStep.ToExactSteps(volume)
+	if !exact || volumeSteps == 0 {
+		return 0, invalidRequestError(invalidVolumeErrMsg, volume, instrument.Pair, instrument.VolumeStep)
+	}
+	return volumeSteps, nil
+}
+
+// getFilledVolumeSteps counts the filled volume of an order in volume steps like getVolumeSteps, an order
+// that was not filled at all has zero of them.
+func getFilledVolumeSteps(instrument *models.InstrumentModel, filledVolume float64) (int64, error) {
+	filledVolumeSteps, exact := instrument.VolumeStep.ToExactSteps(filledVolume)
+	if !exact || filledVolumeSteps < 0 {
+		return 0, invalidRequestError(invalidVolumeErrMsg, filledVolume, instrument.Pair, instrument.VolumeStep)
+	}
+	return filledVolumeSteps, nil
+}
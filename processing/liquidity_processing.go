@@ -0,0 +1,121 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// storedLevel is a price level as kept in redis, price in ticks and volume in steps.
+type storedLevel struct {
+	priceTicks, volumeSteps int64
+}
+
+// GetLiquidityMetricsEvent returns the level count, volume and spread of the book of the pair
+// and the volume of both sides within every LiquidityDepthPercents of the mid price.
+func (q *QuoteProcessing) GetLiquidityMetricsEvent(pair string) (*proto.LiquidityMetricsEvent, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both sides are read in one MULTI, so the spread and the mid price match the book.
+	directions := []string{proto.OrderDirection_BUY.String(), proto.OrderDirection_SELL.String()}
+	pricesCmds := make([]*redis.StringSliceCmd, len(directions))
+	volumesCmds := make([]*redis.MapStringStringCmd, len(directions))
+	_, err = q.RedisClient.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		for i, direction := range directions {
+			pricesCmds[i] = rangePrices(pipe, direction, pair, -1)
+			volumesCmds[i] = pipe.HGetAll(context.Background(), fmt.Sprintf(marketDepthVolumesKey, direction, pair))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bids, err := parseStoredLevels(pricesCmds[0].Val(), volumesCmds[0].Val())
+	if err != nil {
+		return nil, err
+	}
+	asks, err := parseStoredLevels(pricesCmds[1].Val(), volumesCmds[1].Val())
+	if err != nil {
+		return nil, err
+	}
+
+	liquidityMetricsEvent := &proto.LiquidityMetricsEvent{
+		Pair:      instrument.Id,
+		BidLevels: uint32(len(bids)),
+		AskLevels: uint32(len(asks)),
+		BidVolume: instrument.VolumeStep.ToFloat(sumVolumeSteps(bids)),
+		AskVolume: instrument.VolumeStep.ToFloat(sumVolumeSteps(asks)),
+	}
+
+	// The mid price is kept in half ticks, so it stays exact between two ticks.
+	var midHalfTicks int64
+	if len(bids) != 0 && len(asks) != 0 {
+		midHalfTicks = bids[0].priceTicks + asks[0].priceTicks
+		liquidityMetricsEvent.SpreadTicks = asks[0].priceTicks - bids[0].priceTicks
+		liquidityMetricsEvent.MidPrice = instrument.PriceTick.Half().ToFloat(midHalfTicks)
+	}
+
+	for _, percent := range q.LiquidityDepthPercents {
+		depthBand := &proto.LiquidityDepthBand{Percent: percent}
+		if midHalfTicks != 0 {
+			// Levels are ordered best price first, so every side is summed until its first level outside the band.
+			var bandBidSteps, bandAskSteps int64
+			minBidHalfTicks, maxAskHalfTicks := float64(midHalfTicks)*(1-percent/100), float64(midHalfTicks)*(1+percent/100)
+			for _, bid := range bids {
+				if float64(2*bid.priceTicks) < minBidHalfTicks {
+					break
+				}
+				bandBidSteps += bid.volumeSteps
+			}
+			for _, ask := range asks {
+				if float64(2*ask.priceTicks) > maxAskHalfTicks {
+					break
+				}
+				bandAskSteps += ask.volumeSteps
+			}
+
+			depthBand.BidVolume = instrument.VolumeStep.ToFloat(bandBidSteps)
+			depthBand.AskVolume = instrument.VolumeStep.ToFloat(bandAskSteps)
+			if bandBidSteps+bandAskSteps != 0 {
+				depthBand.Imbalance = float64(bandBidSteps-bandAskSteps) / float64(bandBidSteps+bandAskSteps)
+			}
+		}
+
+		liquidityMetricsEvent.DepthBands = append(liquidityMetricsEvent.DepthBands, depthBand)
+	}
+
+	return liquidityMetricsEvent, nil
+}
+
+// parseStoredLevels pairs the ordered level prices of a book side with their volumes.
+func parseStoredLevels(prices []string, volumes map[string]string) ([]storedLevel, error) {
+	levels := make([]storedLevel, 0, len(prices))
+	for _, price := range prices {
+		priceTicks, err := strconv.ParseInt(price, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		volumeSteps, err := strconv.ParseInt(volumes[price], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, storedLevel{priceTicks: priceTicks, volumeSteps: volumeSteps})
+	}
+
+	return levels, nil
+}
+
+func sumVolumeSteps(levels []storedLevel) int64 {
+	var volumeSteps int64
+	for _, level := range levels {
+		volumeSteps += level.volumeSteps
+	}
+	return volumeSteps
+}
@@ -0,0 +1,56 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"testing"
+)
+
+func TestGetLiquidityMetricsEvent(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	q.LiquidityDepthPercents = []float64{0.05, 1}
+	levels := []struct {
+		direction     proto.OrderDirection
+		price, volume float64
+	}{
+		{direction: proto.OrderDirection_BUY, price: 0.9999, volume: 1},
+		{direction: proto.OrderDirection_BUY, price: 0.999, volume: 2},
+		{direction: proto.OrderDirection_SELL, price: 1.0002, volume: 3},
+		{direction: proto.OrderDirection_SELL, price: 1.002, volume: 1},
+	}
+	for _, level := range levels {
+		if _, _, err := q.UpdateMarketDepth(nextUpdateId(), level.direction.String(), testPair, level.price, level.volume); err != nil {
+			t.Fatalf("UpdateMarketDepth() error = %v", err)
+		}
+	}
+
+	liquidityMetricsEvent, err := q.GetLiquidityMetricsEvent(testPair)
+	if err != nil {
+		t.Fatalf("GetLiquidityMetricsEvent() error = %v", err)
+	}
+
+	if liquidityMetricsEvent.MidPrice != 1.00005 || liquidityMetricsEvent.SpreadTicks != 3 {
+		t.Errorf("mid price %v, spread %d ticks, want 1.00005, 3 ticks", liquidityMetricsEvent.MidPrice, liquidityMetricsEvent.SpreadTicks)
+	}
+	if liquidityMetricsEvent.BidVolume != 3 || liquidityMetricsEvent.AskVolume != 4 {
+		t.Errorf("bid volume %v, ask volume %v, want 3, 4", liquidityMetricsEvent.BidVolume, liquidityMetricsEvent.AskVolume)
+	}
+
+	tests := []struct {
+		percent              float64
+		bidVolume, askVolume float64
+		imbalance            float64
+	}{
+		{percent: 0.05, bidVolume: 1, askVolume: 3, imbalance: -0.5},
+		{percent: 1, bidVolume: 3, askVolume: 4, imbalance: -1.0 / 7},
+	}
+	if len(liquidityMetricsEvent.DepthBands) != len(tests) {
+		t.Fatalf("len(DepthBands) = %d, want %d", len(liquidityMetricsEvent.DepthBands), len(tests))
+	}
+	for i, tt := range tests {
+		depthBand := liquidityMetricsEvent.DepthBands[i]
+		if depthBand.Percent != tt.percent || depthBand.BidVolume != tt.bidVolume || depthBand.AskVolume != tt.askVolume || depthBand.Imbalance != tt.imbalance {
+			t.Errorf("band %v%% = bid %v, ask %v, imbalance %v, want bid %v, ask %v, imbalance %v", depthBand.Percent,
+				depthBand.BidVolume, depthBand.AskVolume, depthBand.Imbalance, tt.bidVolume, tt.askVolume, tt.imbalance)
+		}
+	}
+}
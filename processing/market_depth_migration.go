@@ -0,0 +1,96 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	legacyMarketDepthKey = "marketdepth:%s"
+
+	maxMigrateMarketDepthRetries = 10
+)
+
+// MigrateMarketDepth moves books stored in the legacy marketdepth:<direction> hashes, where every pair
+// was a JSON array of float levels, into the per pair/direction sorted set and volume hash layout with
+// prices in ticks and volumes in steps. Every direction is migrated in a transaction that also removes
+// its legacy hash, so running it again or from several replicas at once is safe.
+func (q *QuoteProcessing) MigrateMarketDepth() error {
+	for stringDirection := range proto.OrderDirection_value {
+		if err := q.migrateDirectionMarketDepth(stringDirection); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (q *QuoteProcessing) migrateDirectionMarketDepth(direction string) error {
+	legacyKey := fmt.Sprintf(legacyMarketDepthKey, direction)
+
+	return q.watchWithRetries(func(tx *redis.Tx) error {
+		keyType, err := tx.Type(context.Background(), legacyKey).Result()
+		if err != nil {
+			return err
+		}
+		if keyType != "hash" {
+			return nil
+		}
+
+		legacyMarketDepth, err := tx.HGetAll(context.Background(), legacyKey).Result()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+			for pair, volumeByPriceJson := range legacyMarketDepth {
+				if volumeByPriceJson == "{}" || volumeByPriceJson == "[]" {
+					continue
+				}
+
+				instrument, exists := q.Instruments.Get(pair)
+				if !exists {
+					return fmt.Errorf(unknownInstrumentErrMsg, pair)
+				}
+
+				var volumeByPriceSlice []*proto.VolumeByPrice
+				if err := json.Unmarshal([]byte(volumeByPriceJson), &volumeByPriceSlice); err != nil {
+					return err
+				}
+
+				for _, volumeByPrice := range volumeByPriceSlice {
+					volumeSteps := instrument.VolumeStep.ToSteps(volumeByPrice.Volume)
+					if volumeSteps <= 0 {
+						continue
+					}
+
+					priceTicks := instrument.PriceTick.ToSteps(volumeByPrice.Price)
+					pipe.ZAdd(context.Background(), fmt.Sprintf(marketDepthPricesKey, direction, pair), redis.Z{Score: float64(priceTicks), Member: priceTicks})
+					pipe.HIncrBy(context.Background(), fmt.Sprintf(marketDepthVolumesKey, direction, pair), strconv.FormatInt(priceTicks, 10), volumeSteps)
+				}
+			}
+
+			pipe.Del(context.Background(), legacyKey)
+			return nil
+		})
+		return err
+	}, legacyKey)
+}
+
+// watchWithRetries runs txFunc under WATCH of keys, retrying while other clients modify them.
+func (q *QuoteProcessing) watchWithRetries(txFunc func(*redis.Tx) error, keys ...string) error {
+	var err error
+	for i := 0; i < maxMigrateMarketDepthRetries; i++ {
+		if err = q.RedisClient.Watch(context.Background(), txFunc, keys...); !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+
+	return err
+}
@@ -1,147 +1,403 @@
 package processing
 
 import (
+	"QuoteService/models"
 	"QuoteService/proto"
+	"QuoteService/registry"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
 type QuoteProcessing struct {
 	RedisClient *redis.Client
+
+	// MaxMarketDepthLevels limits the levels per side published in MarketDepthEvent, 0 keeps the full book.
+	MaxMarketDepthLevels int
+	// MaxMarketDepthLevelsByPair overrides MaxMarketDepthLevels for the pairs it contains.
+	MaxMarketDepthLevelsByPair map[string]int
+
+	// LiquidityDepthPercents are the distances from the mid price in percent liquidity metrics sum the book volume within.
+	LiquidityDepthPercents []float64
+
+	// MaxTradesPerPair caps the trade tape of every pair, older trades are trimmed.
+	MaxTradesPerPair int64
+
+	// AveragePriceWindows are the windows VWAP and TWAP of every pair are calculated over.
+	AveragePriceWindows []models.AveragePriceWindowModel
+	// SessionStart is the time of day in UTC the daily trading session starts at.
+	SessionStart time.Duration
+
+	// ArbitrageFeeRate is the fee taken from every leg of an arbitrage round trip, 0.001 is 0.1%.
+	ArbitrageFeeRate float64
+	// ArbitrageFeeRateByPair overrides ArbitrageFeeRate for the pairs it contains.
+	ArbitrageFeeRateByPair map[string]float64
+	// MinArbitrageProfitPercent is the profit after fees a round trip must exceed to be an opportunity.
+	MinArbitrageProfitPercent float64
+
+	// Instruments set the price tick and volume step prices and volumes of every pair are rounded to and counted in.
+	Instruments *registry.InstrumentRegistry
 }
 
 var (
-	marketDepthKey = "marketdepth:%s"
+	marketDepthPricesKey   = "marketdepth:%s:%s:prices"
+	marketDepthVolumesKey  = "marketdepth:%s:%s:volumes"
+	marketDepthSequenceKey = "marketdepth:%s:sequence"
+	marketDepthAppliedKey  = "marketdepth:%s:applied:%s"
+
+	// marketDepthAppliedTtl is how long an applied update is remembered, redeliveries and dead letter replays
+	// of its message within it are not applied twice.
+	marketDepthAppliedTtl = 24 * time.Hour
 
 	invalidOrderPairErrMsg      = "invalid order pair: %s"
 	invalidOrderDirectionErrMsg = "invalid order direction: %s"
+	overfilledOrderErrMsg       = "invalid filled volume: %v of order with volume: %v for pair: %s"
+	invalidVolumeByPriceErrMsg  = "invalid volume by price reply for pair: %s and direction: %s"
+	invalidUpdateReplyErrMsg    = "invalid market depth update reply for pair: %s and direction: %s"
 )
 
-func (q *QuoteProcessing) UpdateMarketDepth(direction, pair string, price, volume float64) (*proto.MarketDepthEvent, error) {
-	if err := q.checkMarketDepthExist(); err != nil {
-		return nil, err
+// updateVolumeByPriceScript adds a volume delta to one price level of a pair/direction book.
+// Prices are stored as whole price ticks and volumes as whole volume steps. The sorted set
+// (KEYS[1]) keeps the level prices ordered, the hash (KEYS[2]) keeps the volume of every level.
+// Levels that drop to zero or below are removed from both. Every update bumps the pair
+// sequence (KEYS[3]) and replies with [new volume, sequence, 1]. The update is remembered
+// in KEYS[4] for ARGV[3] seconds, an update already remembered there is not applied again
+// and replies with [current volume, current sequence, 0].
+var updateVolumeByPriceScript = redis.NewScript(`
+if not redis.call('SET', KEYS[4], 1, 'NX', 'EX', ARGV[3]) then
+	return {tonumber(redis.call('HGET', KEYS[2], ARGV[1]) or '0'), tonumber(redis.call('GET', KEYS[3]) or '0'), 0}
+end
+local volume = redis.call('HINCRBY', KEYS[2], ARGV[1], ARGV[2])
+local sequence = redis.call('INCR', KEYS[3])
+if volume <= 0 then
+	redis.call('HDEL', KEYS[2], ARGV[1])
+	redis.call('ZREM', KEYS[1], ARGV[1])
+	return {0, sequence, 1}
+end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[1])
+return {volume, sequence, 1}
+`)
+
+// getVolumeByPriceScript returns the levels of a pair/direction book best price first
+// (descending when ARGV[3] is 1, ascending otherwise) as a flat
+// [price, volume, price, volume, ...] reply read in a single atomic step.
+var getVolumeByPriceScript = redis.NewScript(`
+local rangeCommand = 'ZRANGE'
+if ARGV[3] == '1' then
+	rangeCommand = 'ZREVRANGE'
+end
+local prices = redis.call(rangeCommand, KEYS[1], ARGV[1], ARGV[2])
+if #prices == 0 then
+	return {}
+end
+local volumes = redis.call('HMGET', KEYS[2], unpack(prices))
+local reply = {}
+for i, price in ipairs(prices) do
+	reply[#reply + 1] = price
+	reply[#reply + 1] = volumes[i] or '0'
+end
+return reply
+`)
+
+// UpdateMarketDepth adds volume to the price level of the pair/direction book and returns
+// the diff with the new volume of the level and the pair sequence it was applied with.
+// updateId names the update, such as the order event it comes from: an update with the same id
+// is applied only once, so redelivered messages do not change the book again, and reports false.
+func (q *QuoteProcessing) UpdateMarketDepth(updateId, direction, pair string, price, volume float64) (*proto.MarketDepthDiffEvent, bool, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, false, err
 	}
+	volumeSteps, err := getVolumeSteps(instrument, volume)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return q.updateMarketDepth(updateId, instrument, direction, price, volumeSteps)
+}
 
-	currentVolumeByPriceSlice, err := q.getCurrentVolumeByPriceSlice(direction, pair)
+// RemoveOrderFromMarketDepth removes the part of a removed order that was not filled from its price level
+// like UpdateMarketDepth. The init and filled volumes are counted in volume steps before they are subtracted,
+// so no float64 remainder such as 0.3 - 0.1 = 0.19999999999999998 is rejected. An order that was filled
+// completely left nothing in the book and returns no diff.
+func (q *QuoteProcessing) RemoveOrderFromMarketDepth(updateId, direction, pair string, price, initVolume, filledVolume float64) (*proto.MarketDepthDiffEvent, bool, error) {
+	instrument, err := q.getInstrument(pair)
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+	initVolumeSteps, err := getVolumeSteps(instrument, initVolume)
+	if err != nil {
+		return nil, false, err
+	}
+	filledVolumeSteps, err := getFilledVolumeSteps(instrument, filledVolume)
+	if err != nil {
+		return nil, false, err
+	}
+	if filledVolumeSteps > initVolumeSteps {
+		return nil, false, invalidRequestError(overfilledOrderErrMsg, filledVolume, initVolume, pair)
+	}
+	if filledVolumeSteps == initVolumeSteps {
+		return nil, false, nil
 	}
 
-	actualVolumeByPrice := q.addVolumeToPrice(currentVolumeByPriceSlice, price, volume)
-	newVolumeByPriceSlice := q.removeZeroVolume(actualVolumeByPrice)
+	return q.updateMarketDepth(updateId, instrument, direction, price, filledVolumeSteps-initVolumeSteps)
+}
 
-	volumeByPriceJson, err := json.Marshal(newVolumeByPriceSlice)
+func (q *QuoteProcessing) updateMarketDepth(updateId string, instrument *models.InstrumentModel, direction string, price float64, volumeSteps int64) (*proto.MarketDepthDiffEvent, bool, error) {
+	directionValue, exists := proto.OrderDirection_value[direction]
+	if !exists {
+		return nil, false, invalidRequestError(invalidOrderDirectionErrMsg, direction)
+	}
+	priceTicks, err := getPriceTicks(instrument, price)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	if err = q.RedisClient.HSet(context.Background(), fmt.Sprintf(marketDepthKey, direction), pair, volumeByPriceJson).Err(); err != nil {
-		return nil, err
+	newVolumeSteps, sequence, applied, err := q.updateVolumeByPrice(updateId, direction, instrument.Pair, priceTicks, volumeSteps)
+	if err != nil {
+		return nil, false, err
 	}
 
-	return q.GetMarketDepthEvent()
+	return &proto.MarketDepthDiffEvent{
+		Pair:     instrument.Id,
+		Sequence: sequence,
+		Levels: []*proto.PriceLevelUpdate{{
+			Direction: proto.OrderDirection(directionValue),
+			Price:     instrument.PriceTick.ToFloat(priceTicks),
+			Volume:    instrument.VolumeStep.ToFloat(newVolumeSteps),
+		}},
+	}, applied, nil
 }
 
+func (q *QuoteProcessing) updateVolumeByPrice(updateId, direction, pair string, priceTicks, volumeSteps int64) (int64, uint64, bool, error) {
+	keys := []string{
+		fmt.Sprintf(marketDepthPricesKey, direction, pair),
+		fmt.Sprintf(marketDepthVolumesKey, direction, pair),
+		fmt.Sprintf(marketDepthSequenceKey, pair),
+		fmt.Sprintf(marketDepthAppliedKey, pair, updateId),
+	}
+	reply, err := updateVolumeByPriceScript.Run(context.Background(), q.RedisClient, keys, priceTicks, volumeSteps, int64(marketDepthAppliedTtl.Seconds())).Int64Slice()
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	if len(reply) != 3 {
+		return 0, 0, false, fmt.Errorf(invalidUpdateReplyErrMsg, pair, direction)
+	}
+
+	return reply[0], uint64(reply[1]), reply[2] == 1, nil
+}
+
+// GetMarketDepthEvent returns the book of every pair truncated to the configured number of levels per side.
 func (q *QuoteProcessing) GetMarketDepthEvent() (*proto.MarketDepthEvent, error) {
-	if err := q.checkMarketDepthExist(); err != nil {
+	return q.getMarketDepthEvent(false)
+}
+
+// GetFullMarketDepthEvent returns the book of every pair with all stored levels.
+func (q *QuoteProcessing) GetFullMarketDepthEvent() (*proto.MarketDepthEvent, error) {
+	return q.getMarketDepthEvent(true)
+}
+
+// GetPairMarketDepthEvent returns the book of one pair truncated to the configured number of levels per side.
+func (q *QuoteProcessing) GetPairMarketDepthEvent(pair string) (*proto.MarketDepthEvent, error) {
+	pairMarketDepth, err := q.getPairMarketDepth(pair, false)
+	if err != nil {
 		return nil, err
 	}
 
+	return &proto.MarketDepthEvent{MarketDepth: pairMarketDepth}, nil
+}
+
+func (q *QuoteProcessing) getMarketDepthEvent(full bool) (*proto.MarketDepthEvent, error) {
 	var marketDepthEvent proto.MarketDepthEvent
-	for stringDirection := range proto.OrderDirection_value {
-		for stringPair := range proto.OrderPair_value {
-			currentVolumeByPriceSlice, err := q.getCurrentVolumeByPriceSlice(stringDirection, stringPair)
-			if err != nil {
-				return nil, err
-			}
+	for _, stringPair := range q.Instruments.Pairs() {
+		pairMarketDepth, err := q.getPairMarketDepth(stringPair, full)
+		if err != nil {
+			return nil, err
+		}
 
-			if currentVolumeByPriceSlice == nil {
-				continue
-			}
+		marketDepthEvent.MarketDepth = append(marketDepthEvent.MarketDepth, pairMarketDepth...)
+	}
 
-			pairValue, exists := proto.OrderPair_value[stringPair]
-			if !exists {
-				return nil, fmt.Errorf(invalidOrderPairErrMsg, stringPair)
-			}
-			directionValue, exists := proto.OrderDirection_value[stringDirection]
-			if !exists {
-				return nil, fmt.Errorf(invalidOrderDirectionErrMsg, stringDirection)
-			}
+	return &marketDepthEvent, nil
+}
+
+func (q *QuoteProcessing) getPairMarketDepth(stringPair string, full bool) ([]*proto.PairMatketDepth, error) {
+	instrument, err := q.getInstrument(stringPair)
+	if err != nil {
+		return nil, err
+	}
+
+	maxLevels := 0
+	if !full {
+		maxLevels = q.getMaxMarketDepthLevels(stringPair)
+	}
 
-			marketDepthEvent.MarketDepth = append(marketDepthEvent.MarketDepth, &proto.PairMatketDepth{
-				Pair:          proto.OrderPair(pairValue),
-				Direction:     proto.OrderDirection(directionValue),
-				VolumeByPrice: currentVolumeByPriceSlice,
-			})
+	var pairMarketDepth []*proto.PairMatketDepth
+	for _, stringDirection := range sortedEnumNames(proto.OrderDirection_name) {
+		currentVolumeByPriceSlice, err := q.getVolumeByPriceSlice(stringDirection, stringPair, maxLevels)
+		if err != nil {
+			return nil, err
+		}
+
+		if currentVolumeByPriceSlice == nil {
+			continue
 		}
+
+		directionValue, exists := proto.OrderDirection_value[stringDirection]
+		if !exists {
+			return nil, fmt.Errorf(invalidOrderDirectionErrMsg, stringDirection)
+		}
+
+		pairMarketDepth = append(pairMarketDepth, &proto.PairMatketDepth{
+			Pair:          instrument.Id,
+			Direction:     proto.OrderDirection(directionValue),
+			VolumeByPrice: currentVolumeByPriceSlice,
+		})
 	}
 
-	return &marketDepthEvent, nil
+	return pairMarketDepth, nil
 }
 
 func (q *QuoteProcessing) getCurrentVolumeByPriceSlice(direction, pair string) ([]*proto.VolumeByPrice, error) {
-	currentByteVolumeByPrice, err := q.RedisClient.HGet(context.Background(), fmt.Sprintf(marketDepthKey, direction), pair).Result()
+	return q.getVolumeByPriceSlice(direction, pair, 0)
+}
+
+// getVolumeByPriceSlice returns up to maxLevels best levels of the book, 0 returns all of them.
+func (q *QuoteProcessing) getVolumeByPriceSlice(direction, pair string, maxLevels int) ([]*proto.VolumeByPrice, error) {
+	keys := []string{fmt.Sprintf(marketDepthPricesKey, direction, pair), fmt.Sprintf(marketDepthVolumesKey, direction, pair)}
+	reply, err := getVolumeByPriceScript.Run(context.Background(), q.RedisClient, keys, 0, maxLevels-1, isDescendingDirection(direction)).StringSlice()
 	if err != nil {
 		return nil, err
 	}
 
-	if currentByteVolumeByPrice == "{}" || currentByteVolumeByPrice == "[]" {
-		return nil, nil
+	if len(reply)%2 != 0 {
+		return nil, fmt.Errorf(invalidVolumeByPriceErrMsg, pair, direction)
 	}
 
 	var currentVolumeByPriceSlice []*proto.VolumeByPrice
-	if err = json.Unmarshal([]byte(currentByteVolumeByPrice), &currentVolumeByPriceSlice); err != nil {
-		return nil, err
+	for i := 0; i < len(reply); i += 2 {
+		volumeByPrice, err := q.parseVolumeByPrice(pair, reply[i], reply[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		currentVolumeByPriceSlice = append(currentVolumeByPriceSlice, volumeByPrice)
 	}
 
 	return currentVolumeByPriceSlice, nil
 }
 
-func (q *QuoteProcessing) addVolumeToPrice(volumeByPriceSlice []*proto.VolumeByPrice, price, volume float64) []*proto.VolumeByPrice {
-	for i, volumeByPrice := range volumeByPriceSlice {
-		if volumeByPrice.Price != price {
-			continue
-		}
-		volumeByPriceSlice[i].Volume += volume
-		return volumeByPriceSlice
+// GetMarketDepthSnapshot returns both sides of the pair book together with the pair sequence,
+// all read in one MULTI so the snapshot matches exactly the diffs published up to that sequence.
+func (q *QuoteProcessing) GetMarketDepthSnapshot(pair string) (*proto.MarketDepthSnapshotResponse, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, err
 	}
 
-	return append(volumeByPriceSlice, &proto.VolumeByPrice{Price: price, Volume: volume})
-}
+	directions := sortedEnumNames(proto.OrderDirection_name)
+	pricesCmds := make([]*redis.StringSliceCmd, len(directions))
+	volumesCmds := make([]*redis.MapStringStringCmd, len(directions))
+	var sequenceCmd *redis.StringCmd
 
-func (q *QuoteProcessing) removeZeroVolume(volumeByPriceSlice []*proto.VolumeByPrice) []*proto.VolumeByPrice {
-	filteredData := []*proto.VolumeByPrice{}
-	for _, volumeByPrice := range volumeByPriceSlice {
-		if volumeByPrice.Volume != 0 {
-			filteredData = append(filteredData, volumeByPrice)
+	_, err = q.RedisClient.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		for i, direction := range directions {
+			pricesCmds[i] = rangePrices(pipe, direction, pair, -1)
+			volumesCmds[i] = pipe.HGetAll(context.Background(), fmt.Sprintf(marketDepthVolumesKey, direction, pair))
 		}
+		sequenceCmd = pipe.Get(context.Background(), fmt.Sprintf(marketDepthSequenceKey, pair))
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
 	}
-	return filteredData
-}
 
-func (q *QuoteProcessing) checkMarketDepthExist() error {
-	for _, directionName := range proto.OrderDirection_name {
-		quotesByPair, err := q.RedisClient.HGetAll(context.Background(), fmt.Sprintf(marketDepthKey, directionName)).Result()
-		if err != nil {
-			return err
-		}
-		if len(quotesByPair) != 0 {
-			continue
+	sequence, err := sequenceCmd.Uint64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	snapshot := &proto.MarketDepthSnapshotResponse{Pair: instrument.Id, Sequence: sequence}
+	for i, direction := range directions {
+		volumes := volumesCmds[i].Val()
+
+		pairMarketDepth := &proto.PairMatketDepth{
+			Pair:      instrument.Id,
+			Direction: proto.OrderDirection(proto.OrderDirection_value[direction]),
 		}
-		for stringPair := range proto.OrderPair_value {
-			volumeByPriceJson, err := json.Marshal(&proto.VolumeByPrice{Price: 0, Volume: 0})
+		for _, price := range pricesCmds[i].Val() {
+			volumeByPrice, err := q.parseVolumeByPrice(pair, price, volumes[price])
 			if err != nil {
-				return err
-			}
-
-			if err := q.RedisClient.HSet(context.Background(), fmt.Sprintf(marketDepthKey, directionName), stringPair, volumeByPriceJson).Err(); err != nil {
-				return err
+				return nil, err
 			}
+			pairMarketDepth.VolumeByPrice = append(pairMarketDepth.VolumeByPrice, volumeByPrice)
 		}
+
+		snapshot.MarketDepth = append(snapshot.MarketDepth, pairMarketDepth)
 	}
 
-	return nil
+	return snapshot, nil
+}
+
+// parseVolumeByPrice converts a stored level, price in ticks and volume in steps, to its proto form.
+func (q *QuoteProcessing) parseVolumeByPrice(pair, stringPriceTicks, stringVolumeSteps string) (*proto.VolumeByPrice, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	priceTicks, err := strconv.ParseInt(stringPriceTicks, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	volumeSteps, err := strconv.ParseInt(stringVolumeSteps, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.VolumeByPrice{Price: instrument.PriceTick.ToFloat(priceTicks), Volume: instrument.VolumeStep.ToFloat(volumeSteps)}, nil
+}
+
+func (q *QuoteProcessing) getMaxMarketDepthLevels(pair string) int {
+	if maxLevels, exists := q.MaxMarketDepthLevelsByPair[pair]; exists {
+		return maxLevels
+	}
+	return q.MaxMarketDepthLevels
+}
+
+// rangePrices queues reading the level prices of the pair/direction book best price first, up to index stop, -1 for all of them.
+func rangePrices(pipe redis.Pipeliner, direction, pair string, stop int64) *redis.StringSliceCmd {
+	pricesKey := fmt.Sprintf(marketDepthPricesKey, direction, pair)
+	if isDescendingDirection(direction) {
+		return pipe.ZRevRange(context.Background(), pricesKey, 0, stop)
+	}
+	return pipe.ZRange(context.Background(), pricesKey, 0, stop)
+}
+
+// isDescendingDirection reports whether the best price of the direction is the highest one:
+// bids (BUY) are ordered from the highest price, asks (SELL) from the lowest.
+func isDescendingDirection(direction string) bool {
+	return direction == proto.OrderDirection_BUY.String()
+}
+
+// sortedEnumNames returns proto enum names ordered by their numeric values,
+// so events list pairs and directions in a stable order.
+func sortedEnumNames(enumNames map[int32]string) []string {
+	values := make([]int32, 0, len(enumNames))
+	for value := range enumNames {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		names = append(names, enumNames[value])
+	}
+	return names
 }
@@ -0,0 +1,284 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"QuoteService/registry"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	testPair = proto.OrderPair_USD_EUR.String()
+
+	testUpdateId int
+)
+
+// nextUpdateId returns a new market depth update id, so every update of a test is applied.
+func nextUpdateId() string {
+	testUpdateId++
+	return strconv.Itoa(testUpdateId)
+}
+
+func newTestQuoteProcessing(t *testing.T) *QuoteProcessing {
+	t.Helper()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { redisClient.Close() })
+
+	instruments, err := registry.NewInstrumentRegistry(redisClient, "../config/instruments.json")
+	if err != nil {
+		t.Fatalf("NewInstrumentRegistry() error = %v", err)
+	}
+
+	return &QuoteProcessing{RedisClient: redisClient, Instruments: instruments}
+}
+
+func TestIsDescendingDirection(t *testing.T) {
+	tests := []struct {
+		direction string
+		want      bool
+	}{
+		{direction: proto.OrderDirection_BUY.String(), want: true},
+		{direction: proto.OrderDirection_SELL.String(), want: false},
+	}
+
+	for _, tt := range tests {
+		if got := isDescendingDirection(tt.direction); got != tt.want {
+			t.Errorf("isDescendingDirection(%s) = %v, want %v", tt.direction, got, tt.want)
+		}
+	}
+}
+
+func TestGetVolumeByPriceSliceOrder(t *testing.T) {
+	type update struct {
+		price, volume float64
+	}
+
+	tests := []struct {
+		name      string
+		direction string
+		updates   []update
+		maxLevels int
+		want      []*proto.VolumeByPrice
+	}{
+		{
+			name:      "buy levels are price descending",
+			direction: proto.OrderDirection_BUY.String(),
+			updates:   []update{{1.0002, 1}, {1.0005, 2}, {0.9999, 3}},
+			want:      []*proto.VolumeByPrice{{Price: 1.0005, Volume: 2}, {Price: 1.0002, Volume: 1}, {Price: 0.9999, Volume: 3}},
+		},
+		{
+			name:      "sell levels are price ascending",
+			direction: proto.OrderDirection_SELL.String(),
+			updates:   []update{{1.0002, 1}, {1.0005, 2}, {0.9999, 3}},
+			want:      []*proto.VolumeByPrice{{Price: 0.9999, Volume: 3}, {Price: 1.0002, Volume: 1}, {Price: 1.0005, Volume: 2}},
+		},
+		{
+			name:      "equal prices are one level",
+			direction: proto.OrderDirection_BUY.String(),
+			updates:   []update{{1.0002, 1}, {1.0003, 0.5}, {1.0002, 0.25}},
+			want:      []*proto.VolumeByPrice{{Price: 1.0003, Volume: 0.5}, {Price: 1.0002, Volume: 1.25}},
+		},
+		{
+			name:      "max levels keeps the best prices",
+			direction: proto.OrderDirection_SELL.String(),
+			updates:   []update{{1.0002, 1}, {1.0005, 2}, {0.9999, 3}},
+			maxLevels: 2,
+			want:      []*proto.VolumeByPrice{{Price: 0.9999, Volume: 3}, {Price: 1.0002, Volume: 1}},
+		},
+		{
+			name:      "removed levels are skipped",
+			direction: proto.OrderDirection_BUY.String(),
+			updates:   []update{{1.0002, 1}, {1.0005, 2}, {1.0005, -2}},
+			want:      []*proto.VolumeByPrice{{Price: 1.0002, Volume: 1}},
+		},
+		{
+			name:      "empty side has no levels",
+			direction: proto.OrderDirection_SELL.String(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuoteProcessing(t)
+			for _, u := range tt.updates {
+				if _, _, err := q.UpdateMarketDepth(nextUpdateId(), tt.direction, testPair, u.price, u.volume); err != nil {
+					t.Fatalf("UpdateMarketDepth() error = %v", err)
+				}
+			}
+
+			got, err := q.getVolumeByPriceSlice(tt.direction, testPair, tt.maxLevels)
+			if err != nil {
+				t.Fatalf("getVolumeByPriceSlice() error = %v", err)
+			}
+			assertVolumeByPrice(t, got, tt.want)
+		})
+	}
+}
+
+func TestGetMarketDepthSnapshotOrder(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	buy, sell := proto.OrderDirection_BUY.String(), proto.OrderDirection_SELL.String()
+	for _, price := range []float64{1.0001, 1.0003, 1.0002} {
+		if _, _, err := q.UpdateMarketDepth(nextUpdateId(), buy, testPair, price, 1); err != nil {
+			t.Fatalf("UpdateMarketDepth() error = %v", err)
+		}
+	}
+
+	snapshot, err := q.GetMarketDepthSnapshot(testPair)
+	if err != nil {
+		t.Fatalf("GetMarketDepthSnapshot() error = %v", err)
+	}
+	if snapshot.Sequence != 3 {
+		t.Errorf("Sequence = %d, want 3", snapshot.Sequence)
+	}
+
+	want := map[string][]*proto.VolumeByPrice{
+		buy:  {{Price: 1.0003, Volume: 1}, {Price: 1.0002, Volume: 1}, {Price: 1.0001, Volume: 1}},
+		sell: nil,
+	}
+	if len(snapshot.MarketDepth) != len(want) {
+		t.Fatalf("got %d sides, want %d", len(snapshot.MarketDepth), len(want))
+	}
+	for _, pairMarketDepth := range snapshot.MarketDepth {
+		t.Run(pairMarketDepth.Direction.String(), func(t *testing.T) {
+			assertVolumeByPrice(t, pairMarketDepth.VolumeByPrice, want[pairMarketDepth.Direction.String()])
+		})
+	}
+}
+
+func assertVolumeByPrice(t *testing.T, got, want []*proto.VolumeByPrice) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d levels, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i].Price != want[i].Price || got[i].Volume != want[i].Volume {
+			t.Errorf("level %d = {%v, %v}, want {%v, %v}", i, got[i].Price, got[i].Volume, want[i].Price, want[i].Volume)
+		}
+	}
+}
+
+func TestGetMarketDepthSnapshotInvalidPair(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+
+	if _, err := q.GetMarketDepthSnapshot("UNKNOWN"); !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("GetMarketDepthSnapshot() error = %v, want %v", err, ErrInvalidRequest)
+	}
+}
+
+func TestUpdateMarketDepthRejectsPartialVolumeSteps(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	buy := proto.OrderDirection_BUY.String()
+
+	for _, volume := range []float64{0.005, 1.015, 0} {
+		if _, _, err := q.UpdateMarketDepth(nextUpdateId(), buy, testPair, 1.0001, volume); !errors.Is(err, ErrInvalidRequest) {
+			t.Errorf("UpdateMarketDepth(%v) error = %v, want %v", volume, err, ErrInvalidRequest)
+		}
+	}
+
+	snapshot, err := q.GetMarketDepthSnapshot(testPair)
+	if err != nil {
+		t.Fatalf("GetMarketDepthSnapshot() error = %v", err)
+	}
+	if snapshot.Sequence != 0 {
+		t.Errorf("Sequence = %d, want 0", snapshot.Sequence)
+	}
+}
+
+func TestUpdateMarketDepthAppliesUpdateOnce(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	buy := proto.OrderDirection_BUY.String()
+
+	tests := []struct {
+		updateId    string
+		volume      float64
+		wantApplied bool
+		wantVolume  float64
+		wantSeq     uint64
+	}{
+		{updateId: "created:1", volume: 2, wantApplied: true, wantVolume: 2, wantSeq: 1},
+		{updateId: "created:1", volume: 2, wantApplied: false, wantVolume: 2, wantSeq: 1},
+		{updateId: "matched:1:2:1", volume: -0.5, wantApplied: true, wantVolume: 1.5, wantSeq: 2},
+		{updateId: "matched:1:2:1", volume: -0.5, wantApplied: false, wantVolume: 1.5, wantSeq: 2},
+	}
+
+	for _, tt := range tests {
+		marketDepthDiffEvent, applied, err := q.UpdateMarketDepth(tt.updateId, buy, testPair, 1.0001, tt.volume)
+		if err != nil {
+			t.Fatalf("UpdateMarketDepth(%s) error = %v", tt.updateId, err)
+		}
+		if applied != tt.wantApplied || marketDepthDiffEvent.Levels[0].Volume != tt.wantVolume || marketDepthDiffEvent.Sequence != tt.wantSeq {
+			t.Errorf("UpdateMarketDepth(%s) = volume %v, sequence %d, applied %v, want %v, %d, %v", tt.updateId,
+				marketDepthDiffEvent.Levels[0].Volume, marketDepthDiffEvent.Sequence, applied, tt.wantVolume, tt.wantSeq, tt.wantApplied)
+		}
+	}
+}
+
+func TestUpdateMarketDepthInvalidPair(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+
+	if _, _, err := q.UpdateMarketDepth(nextUpdateId(), proto.OrderDirection_BUY.String(), "UNKNOWN", 1, 1); !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("UpdateMarketDepth() error = %v, want %v", err, ErrInvalidRequest)
+	}
+}
+
+func TestRemoveOrderFromMarketDepth(t *testing.T) {
+	buy := proto.OrderDirection_BUY.String()
+
+	tests := []struct {
+		name                     string
+		initVolume, filledVolume float64
+		wantVolume               float64
+		wantDiff                 bool
+		wantErr                  error
+	}{
+		{name: "float remainder 0.3 - 0.1", initVolume: 0.3, filledVolume: 0.1, wantVolume: 4.8, wantDiff: true},
+		{name: "float remainder 1.1 - 0.2", initVolume: 1.1, filledVolume: 0.2, wantVolume: 4.1, wantDiff: true},
+		{name: "float remainder 2.3 - 1.1", initVolume: 2.3, filledVolume: 1.1, wantVolume: 3.8, wantDiff: true},
+		{name: "not filled", initVolume: 2, wantVolume: 3, wantDiff: true},
+		{name: "filled completely", initVolume: 1.5, filledVolume: 1.5, wantVolume: 5},
+		{name: "filled more than its volume", initVolume: 1, filledVolume: 1.5, wantErr: ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuoteProcessing(t)
+			if _, _, err := q.UpdateMarketDepth(nextUpdateId(), buy, testPair, 1.0001, 5); err != nil {
+				t.Fatalf("UpdateMarketDepth() error = %v", err)
+			}
+
+			marketDepthDiffEvent, _, err := q.RemoveOrderFromMarketDepth(nextUpdateId(), buy, testPair, 1.0001, tt.initVolume, tt.filledVolume)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RemoveOrderFromMarketDepth() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if (marketDepthDiffEvent != nil) != tt.wantDiff {
+				t.Fatalf("RemoveOrderFromMarketDepth() = %v, want diff %v", marketDepthDiffEvent, tt.wantDiff)
+			}
+
+			levels, err := q.getVolumeByPriceSlice(buy, testPair, 0)
+			if err != nil {
+				t.Fatalf("getVolumeByPriceSlice() error = %v", err)
+			}
+			assertVolumeByPrice(t, levels, []*proto.VolumeByPrice{{Price: 1.0001, Volume: tt.wantVolume}})
+		})
+	}
+}
+
+func TestUpdateMarketDepthRejectsPricesBelowOneTick(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+
+	for _, price := range []float64{0.00004, 0, -1} {
+		if _, _, err := q.UpdateMarketDepth(nextUpdateId(), proto.OrderDirection_BUY.String(), testPair, price, 1); !errors.Is(err, ErrInvalidRequest) {
+			t.Errorf("UpdateMarketDepth(%v) error = %v, want %v", price, err, ErrInvalidRequest)
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
 )
 
 var (
@@ -18,16 +21,32 @@ func (q *QuoteProcessing) UpdateQuotes(pair *proto.OrderPair, price, volume floa
 		return nil, err
 	}
 
-	if err := q.updateRedisQuotes(pair, price, volume); err != nil {
+	stringPair := q.Instruments.Name(*pair)
+	instrument, err := q.getInstrument(stringPair)
+	if err != nil {
+		return nil, err
+	}
+	priceTicks, err := getPriceTicks(instrument, price)
+	if err != nil {
+		return nil, err
+	}
+	volumeSteps, err := getVolumeSteps(instrument, volume)
+	if err != nil {
+		return nil, err
+	}
+	roundedPrice := instrument.PriceTick.ToFloat(priceTicks)
+	roundedVolume := instrument.VolumeStep.ToFloat(volumeSteps)
+
+	if err := q.updateRedisQuotes(stringPair, roundedPrice, roundedVolume); err != nil {
 		return nil, err
 	}
 
 	return q.GetQuotesEvent()
 }
 
-func (q *QuoteProcessing) updateRedisQuotes(pair *proto.OrderPair, price, volume float64) error {
+func (q *QuoteProcessing) updateRedisQuotes(pair string, price, volume float64) error {
 	for stringPair := range q.RedisClient.HGetAll(context.Background(), quotesKey).Val() {
-		if stringPair != pair.String() {
+		if stringPair != pair {
 			continue
 		}
 
@@ -50,11 +69,12 @@ func (q *QuoteProcessing) GetQuotesEvent() (*proto.QuotesEvent, error) {
 		return nil, err
 	}
 
+	now := time.Now()
 	var event proto.QuotesEvent
 	for stringPair, quote := range q.RedisClient.HGetAll(context.Background(), quotesKey).Val() {
-		pairValue, exists := proto.OrderPair_value[stringPair]
-		if !exists {
-			return nil, fmt.Errorf(invalidOrderPairErrMsg, stringPair)
+		instrument, exists := q.Instruments.Get(stringPair)
+		if !exists || !instrument.Enabled {
+			continue
 		}
 
 		var volumeByPrice proto.VolumeByPrice
@@ -62,31 +82,41 @@ func (q *QuoteProcessing) GetQuotesEvent() (*proto.QuotesEvent, error) {
 			return nil, err
 		}
 
-		event.CurrentQuotes = append(event.CurrentQuotes, &proto.PairQuote{Pair: proto.OrderPair(pairValue), Price: volumeByPrice.Price, Volume: volumeByPrice.Volume})
+		averagePrices, err := q.GetAveragePrices(stringPair, now)
+		if err != nil {
+			return nil, err
+		}
+
+		event.CurrentQuotes = append(event.CurrentQuotes, &proto.PairQuote{
+			Pair:          instrument.Id,
+			Price:         volumeByPrice.Price,
+			Volume:        volumeByPrice.Volume,
+			AveragePrices: averagePrices,
+		})
 	}
 
+	crossRates, crossRateDeviations, err := q.getCrossRateQuotes()
+	if err != nil {
+		return nil, err
+	}
+	event.CrossRates = crossRates
+	event.CrossRateDeviations = crossRateDeviations
+
 	return &event, nil
 }
 
+// checkQuotesExist creates the quote of every active pair that has none yet, including pairs enabled at runtime.
 func (q *QuoteProcessing) checkQuotesExist() error {
-	quotesByPair, err := q.RedisClient.HGetAll(context.Background(), quotesKey).Result()
+	volumeByPriceJson, err := json.Marshal(&proto.VolumeByPrice{Price: 0, Volume: 0})
 	if err != nil {
 		return err
 	}
-	if len(quotesByPair) != 0 {
-		return nil
-	}
 
-	for stringPair := range proto.OrderPair_value {
-		volumeByPriceJson, err := json.Marshal(&proto.VolumeByPrice{Price: 0, Volume: 0})
-		if err != nil {
-			return err
+	_, err = q.RedisClient.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		for _, stringPair := range q.Instruments.Pairs() {
+			pipe.HSetNX(context.Background(), quotesKey, stringPair, volumeByPriceJson)
 		}
-
-		if err := q.RedisClient.HSet(context.Background(), quotesKey, stringPair, volumeByPriceJson).Err(); err != nil {
-			return err
-		}
-	}
-
-	return nil
+		return nil
+	})
+	return err
 }
@@ -0,0 +1,192 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	tickerWindow = 24 * time.Hour
+
+	tickerKey        = "ticker:%s"
+	tickerMinutesKey = "ticker:%s:minutes"
+	tickerHighsKey   = "ticker:%s:highs"
+	tickerLowsKey    = "ticker:%s:lows"
+	tickerBucketsKey = "ticker:%s:buckets"
+	tickerAppliedKey = "ticker:%s:applied:%s"
+
+	invalidTickerReplyErrMsg = "invalid ticker reply for pair: %s"
+)
+
+// tickerScript keeps the rolling 24 hour statistics of a pair in minute buckets, so they are updated per trade
+// instead of rescanned. The totals hash (KEYS[1]) keeps the volume, quote volume and trade count of the window and
+// the last price, the sorted sets keep the minutes of the window (KEYS[2]) and the highest (KEYS[3]) and lowest
+// (KEYS[4]) price of every minute, and the buckets hash (KEYS[5]) keeps the open price and the totals of every minute.
+// Minutes that opened before ARGV[1] are first subtracted from the totals and removed. When ARGV[2] is set,
+// a trade of ARGV[3] price ticks, ARGV[4] volume steps and ARGV[5] quote volume in minute ARGV[2] is added, unless
+// it is already remembered in KEYS[6], where it is remembered for ARGV[6] seconds.
+// It replies with [open, high, low, close, volume, quote volume, trades] of the window, or nothing when it is empty.
+var tickerScript = redis.NewScript(`
+local totalsKey, minutesKey, highsKey, lowsKey, bucketsKey = KEYS[1], KEYS[2], KEYS[3], KEYS[4], KEYS[5]
+for _, minute in ipairs(redis.call('ZRANGEBYSCORE', minutesKey, '-inf', '(' .. ARGV[1])) do
+	local bucket = redis.call('HMGET', bucketsKey, minute .. ':volume', minute .. ':quoteVolume', minute .. ':trades')
+	redis.call('HINCRBY', totalsKey, 'volume', -tonumber(bucket[1]))
+	redis.call('HINCRBY', totalsKey, 'quoteVolume', -tonumber(bucket[2]))
+	redis.call('HINCRBY', totalsKey, 'trades', -tonumber(bucket[3]))
+	redis.call('HDEL', bucketsKey, minute .. ':open', minute .. ':volume', minute .. ':quoteVolume', minute .. ':trades')
+	redis.call('ZREM', minutesKey, minute)
+	redis.call('ZREM', highsKey, minute)
+	redis.call('ZREM', lowsKey, minute)
+end
+
+if ARGV[2] and redis.call('SET', KEYS[6], 1, 'NX', 'EX', ARGV[6]) then
+	local minute, price = ARGV[2], tonumber(ARGV[3])
+	if redis.call('ZADD', minutesKey, minute, minute) == 1 then
+		redis.call('HSET', bucketsKey, minute .. ':open', ARGV[3])
+		redis.call('ZADD', highsKey, ARGV[3], minute)
+		redis.call('ZADD', lowsKey, ARGV[3], minute)
+	else
+		if price > tonumber(redis.call('ZSCORE', highsKey, minute)) then
+			redis.call('ZADD', highsKey, ARGV[3], minute)
+		end
+		if price < tonumber(redis.call('ZSCORE', lowsKey, minute)) then
+			redis.call('ZADD', lowsKey, ARGV[3], minute)
+		end
+	end
+	redis.call('HINCRBY', bucketsKey, minute .. ':volume', ARGV[4])
+	redis.call('HINCRBY', bucketsKey, minute .. ':quoteVolume', ARGV[5])
+	redis.call('HINCRBY', bucketsKey, minute .. ':trades', 1)
+	redis.call('HINCRBY', totalsKey, 'volume', ARGV[4])
+	redis.call('HINCRBY', totalsKey, 'quoteVolume', ARGV[5])
+	redis.call('HINCRBY', totalsKey, 'trades', 1)
+	redis.call('HSET', totalsKey, 'close', ARGV[3])
+end
+
+local first = redis.call('ZRANGE', minutesKey, 0, 0)
+if #first == 0 then
+	return {}
+end
+local high = redis.call('ZREVRANGE', highsKey, 0, 0, 'WITHSCORES')
+local low = redis.call('ZRANGE', lowsKey, 0, 0, 'WITHSCORES')
+local totals = redis.call('HMGET', totalsKey, 'close', 'volume', 'quoteVolume', 'trades')
+return {redis.call('HGET', bucketsKey, first[1] .. ':open'), high[2], low[2], totals[1], totals[2], totals[3], totals[4]}
+`)
+
+// UpdateTicker adds a matched trade to the rolling 24 hour statistics of the pair.
+// matchId names the match, a match with the same id is added only once.
+func (q *QuoteProcessing) UpdateTicker(matchId string, pair *proto.OrderPair, price, volume float64, tradeTime time.Time) error {
+	stringPair := q.Instruments.Name(*pair)
+	instrument, err := q.getInstrument(stringPair)
+	if err != nil {
+		return err
+	}
+	priceTicks, err := getPriceTicks(instrument, price)
+	if err != nil {
+		return err
+	}
+	volumeSteps, err := getVolumeSteps(instrument, volume)
+	if err != nil {
+		return err
+	}
+
+	minute := getCandleOpenTime(proto.CandleInterval_MINUTE_1, tradeTime)
+	_, err = q.runTickerScript(stringPair, matchId, tradeTime, minute, priceTicks, volumeSteps, priceTicks*volumeSteps, int64(tradeRecordedTtl.Seconds()))
+	return err
+}
+
+// GetTickerEvent returns the rolling statistics of the pair over the last 24 hours, including the minute that is still open.
+func (q *QuoteProcessing) GetTickerEvent(pair string, now time.Time) (*proto.TickerEvent, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := q.runTickerScript(pair, "", now)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerEvent := &proto.TickerEvent{Pair: instrument.Id}
+	if window == nil {
+		lastQuote, err := q.getQuote(pair)
+		if err != nil {
+			return nil, err
+		}
+
+		tickerEvent.LastPrice, tickerEvent.OpenPrice = lastQuote.Price, lastQuote.Price
+		tickerEvent.HighPrice, tickerEvent.LowPrice = lastQuote.Price, lastQuote.Price
+		return tickerEvent, nil
+	}
+
+	priceTick := instrument.PriceTick
+	tickerEvent.LastPrice = priceTick.ToFloat(window.close)
+	tickerEvent.OpenPrice = priceTick.ToFloat(window.open)
+	tickerEvent.HighPrice = priceTick.ToFloat(window.high)
+	tickerEvent.LowPrice = priceTick.ToFloat(window.low)
+	tickerEvent.Volume = instrument.VolumeStep.ToFloat(window.volume)
+	tickerEvent.QuoteVolume = priceTick.Times(instrument.VolumeStep).ToFloat(window.quoteVolume)
+	tickerEvent.PriceChange = priceTick.ToFloat(window.close - window.open)
+	tickerEvent.PriceChangePercent = float64(window.close-window.open) / float64(window.open) * 100
+	tickerEvent.TradeCount = uint64(window.trades)
+
+	return tickerEvent, nil
+}
+
+// runTickerScript drops the minutes that left the 24 hour window ending with the minute of now, adds the trade of
+// matchId given as [minute, price ticks, volume steps, quote volume, seconds it is remembered] if any, and returns
+// the window, nil when it has no trades.
+func (q *QuoteProcessing) runTickerScript(pair, matchId string, now time.Time, trade ...interface{}) (*storedCandle, error) {
+	keys := []string{
+		fmt.Sprintf(tickerKey, pair),
+		fmt.Sprintf(tickerMinutesKey, pair),
+		fmt.Sprintf(tickerHighsKey, pair),
+		fmt.Sprintf(tickerLowsKey, pair),
+		fmt.Sprintf(tickerBucketsKey, pair),
+		fmt.Sprintf(tickerAppliedKey, pair, matchId),
+	}
+	windowStart := getCandleOpenTime(proto.CandleInterval_MINUTE_1, now) + time.Minute.Milliseconds() - tickerWindow.Milliseconds()
+
+	reply, err := tickerScript.Run(context.Background(), q.RedisClient, keys, append([]interface{}{windowStart}, trade...)...).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+	if len(reply) == 0 {
+		return nil, nil
+	}
+	if len(reply) != 7 {
+		return nil, fmt.Errorf(invalidTickerReplyErrMsg, pair)
+	}
+
+	window := &storedCandle{}
+	for i, value := range []*int64{&window.open, &window.high, &window.low, &window.close, &window.volume, &window.quoteVolume, &window.trades} {
+		if *value, err = strconv.ParseInt(reply[i], 10, 64); err != nil {
+			return nil, fmt.Errorf(invalidTickerReplyErrMsg, pair)
+		}
+	}
+	return window, nil
+}
+
+// getQuote returns the last matched price and volume of the pair, zero when it never traded.
+func (q *QuoteProcessing) getQuote(pair string) (*proto.VolumeByPrice, error) {
+	var volumeByPrice proto.VolumeByPrice
+
+	quote, err := q.RedisClient.HGet(context.Background(), quotesKey, pair).Result()
+	if errors.Is(err, redis.Nil) {
+		return &volumeByPrice, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(quote), &volumeByPrice); err != nil {
+		return nil, err
+	}
+	return &volumeByPrice, nil
+}
@@ -0,0 +1,80 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"testing"
+	"time"
+)
+
+func TestGetTickerEventRollingWindow(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	pair := proto.OrderPair_USD_EUR
+	now := time.Now()
+
+	trades := []struct {
+		age           time.Duration
+		price, volume float64
+	}{
+		{age: 25 * time.Hour, price: 0.9, volume: 100},
+		{age: 2 * time.Hour, price: 1.0002, volume: 1},
+		{age: 2 * time.Hour, price: 1.0010, volume: 2},
+		{age: time.Hour, price: 0.9990, volume: 0.5},
+		{age: 0, price: 1.0004, volume: 1.5},
+	}
+	for _, trade := range trades {
+		if err := q.UpdateTicker(nextUpdateId(), &pair, trade.price, trade.volume, now.Add(-trade.age)); err != nil {
+			t.Fatalf("UpdateTicker() error = %v", err)
+		}
+	}
+
+	tickerEvent, err := q.GetTickerEvent(testPair, now)
+	if err != nil {
+		t.Fatalf("GetTickerEvent() error = %v", err)
+	}
+
+	want := &proto.TickerEvent{
+		Pair:        pair,
+		OpenPrice:   1.0002,
+		HighPrice:   1.001,
+		LowPrice:    0.999,
+		LastPrice:   1.0004,
+		Volume:      5,
+		QuoteVolume: 1.0002 + 2*1.001 + 0.5*0.999 + 1.5*1.0004,
+		PriceChange: 0.0002,
+		TradeCount:  4,
+	}
+	if tickerEvent.OpenPrice != want.OpenPrice || tickerEvent.HighPrice != want.HighPrice || tickerEvent.LowPrice != want.LowPrice ||
+		tickerEvent.LastPrice != want.LastPrice || tickerEvent.Volume != want.Volume || tickerEvent.PriceChange != want.PriceChange ||
+		tickerEvent.TradeCount != want.TradeCount || tickerEvent.QuoteVolume-want.QuoteVolume > 1e-9 || want.QuoteVolume-tickerEvent.QuoteVolume > 1e-9 {
+		t.Errorf("GetTickerEvent() = %v, want %v", tickerEvent, want)
+	}
+
+	// Once every trade left the window the ticker falls back to the last quote.
+	tickerEvent, err = q.GetTickerEvent(testPair, now.Add(25*time.Hour))
+	if err != nil {
+		t.Fatalf("GetTickerEvent() error = %v", err)
+	}
+	if tickerEvent.TradeCount != 0 || tickerEvent.Volume != 0 {
+		t.Errorf("GetTickerEvent() after the window = %v, want no trades", tickerEvent)
+	}
+}
+
+func TestUpdateTickerAddsMatchOnce(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	pair := proto.OrderPair_USD_EUR
+	now := time.Now()
+
+	for _, matchId := range []string{"book:1", "book:1", "book:2"} {
+		if err := q.UpdateTicker(matchId, &pair, 1.0001, 1, now); err != nil {
+			t.Fatalf("UpdateTicker(%s) error = %v", matchId, err)
+		}
+	}
+
+	tickerEvent, err := q.GetTickerEvent(testPair, now)
+	if err != nil {
+		t.Fatalf("GetTickerEvent() error = %v", err)
+	}
+	if tickerEvent.TradeCount != 2 || tickerEvent.Volume != 2 {
+		t.Errorf("GetTickerEvent() = %d trades of %v, want 2 trades of 2", tickerEvent.TradeCount, tickerEvent.Volume)
+	}
+}
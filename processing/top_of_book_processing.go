@@ -0,0 +1,128 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	topOfBookKey         = "topofbook"
+	topOfBookSequenceKey = "topofbook:sequence"
+
+	invalidTopOfBookReplyErrMsg = "invalid top of book reply for pair: %s"
+)
+
+// getTopOfBookScript reads the best level of both sides of a pair book together with the pair sequence.
+// KEYS are BUY prices, BUY volumes, SELL prices, SELL volumes and the pair sequence, the reply is
+// [sequence, bid price, bid volume, ask price, ask volume] with empty strings for a side without levels.
+var getTopOfBookScript = redis.NewScript(`
+local function bestLevel(pricesKey, volumesKey, rangeCommand)
+	local prices = redis.call(rangeCommand, pricesKey, 0, 0)
+	if #prices == 0 then
+		return '', ''
+	end
+	return prices[1], redis.call('HGET', volumesKey, prices[1]) or '0'
+end
+
+local bidPrice, bidVolume = bestLevel(KEYS[1], KEYS[2], 'ZREVRANGE')
+local askPrice, askVolume = bestLevel(KEYS[3], KEYS[4], 'ZRANGE')
+return {redis.call('GET', KEYS[5]) or '0', bidPrice, bidVolume, askPrice, askVolume}
+`)
+
+// setTopOfBookScript stores the top of book (ARGV[3]) of a pair (ARGV[2]) computed at a book sequence (ARGV[1]).
+// Tops computed from an older book than the stored one are ignored, so concurrent listeners never
+// move it back. Replies 1 only when the stored top of book changed.
+var setTopOfBookScript = redis.NewScript(`
+local lastSequence = tonumber(redis.call('HGET', KEYS[2], ARGV[2]) or '-1')
+if tonumber(ARGV[1]) <= lastSequence then
+	return 0
+end
+redis.call('HSET', KEYS[2], ARGV[2], ARGV[1])
+if redis.call('HGET', KEYS[1], ARGV[2]) == ARGV[3] then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[2], ARGV[3])
+return 1
+`)
+
+// GetTopOfBookEvent returns the best bid and ask of the pair with the spread and mid price between them.
+func (q *QuoteProcessing) GetTopOfBookEvent(pair string) (*proto.TopOfBookEvent, error) {
+	topOfBookEvent, _, err := q.getTopOfBook(pair)
+	return topOfBookEvent, err
+}
+
+// UpdateTopOfBook recomputes the top of book of the pair after its book changed
+// and reports whether the best levels differ from the previously stored ones.
+func (q *QuoteProcessing) UpdateTopOfBook(pair string) (*proto.TopOfBookEvent, bool, error) {
+	topOfBookEvent, sequence, err := q.getTopOfBook(pair)
+	if err != nil {
+		return nil, false, err
+	}
+
+	topOfBookJson, err := json.Marshal(topOfBookEvent)
+	if err != nil {
+		return nil, false, err
+	}
+
+	changed, err := setTopOfBookScript.Run(context.Background(), q.RedisClient, []string{topOfBookKey, topOfBookSequenceKey}, sequence, pair, topOfBookJson).Bool()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return topOfBookEvent, changed, nil
+}
+
+func (q *QuoteProcessing) getTopOfBook(pair string) (*proto.TopOfBookEvent, uint64, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	buyDirection, sellDirection := proto.OrderDirection_BUY.String(), proto.OrderDirection_SELL.String()
+	keys := []string{
+		fmt.Sprintf(marketDepthPricesKey, buyDirection, pair),
+		fmt.Sprintf(marketDepthVolumesKey, buyDirection, pair),
+		fmt.Sprintf(marketDepthPricesKey, sellDirection, pair),
+		fmt.Sprintf(marketDepthVolumesKey, sellDirection, pair),
+		fmt.Sprintf(marketDepthSequenceKey, pair),
+	}
+	reply, err := getTopOfBookScript.Run(context.Background(), q.RedisClient, keys).StringSlice()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(reply) != 5 {
+		return nil, 0, fmt.Errorf(invalidTopOfBookReplyErrMsg, pair)
+	}
+
+	sequence, err := strconv.ParseUint(reply[0], 10, 64)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	topOfBookEvent := &proto.TopOfBookEvent{Pair: instrument.Id}
+	if reply[1] != "" {
+		if topOfBookEvent.BestBid, err = q.parseVolumeByPrice(pair, reply[1], reply[2]); err != nil {
+			return nil, 0, err
+		}
+	}
+	if reply[3] != "" {
+		if topOfBookEvent.BestAsk, err = q.parseVolumeByPrice(pair, reply[3], reply[4]); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if topOfBookEvent.BestBid != nil && topOfBookEvent.BestAsk != nil {
+		priceTick := instrument.PriceTick
+		bestBidTicks, bestAskTicks := priceTick.ToSteps(topOfBookEvent.BestBid.Price), priceTick.ToSteps(topOfBookEvent.BestAsk.Price)
+		topOfBookEvent.Spread = priceTick.ToFloat(bestAskTicks - bestBidTicks)
+		topOfBookEvent.MidPrice = priceTick.Half().ToFloat(bestAskTicks + bestBidTicks)
+	}
+
+	return topOfBookEvent, sequence, nil
+}
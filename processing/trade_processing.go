@@ -0,0 +1,167 @@
+package processing
+
+import (
+	"QuoteService/models"
+	"QuoteService/proto"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	tradesKey        = "trades:%s"
+	tradeRecordedKey = "trades:%s:recorded:%s:%s"
+	// tradeRecordedTtl is how long a match is remembered in the trade tape, candles, average prices and the ticker,
+	// redeliveries and dead letter replays of its message within it are not counted twice.
+	tradeRecordedTtl = 24 * time.Hour
+
+	defaultRecentTradesLimit = 100
+
+	invalidTradesLimitErrMsg   = "invalid trades limit: %d"
+	invalidTradeErrMsg         = "invalid trade %s for pair: %s, field: %s"
+	invalidAddTradeReplyErrMsg = "invalid add trade reply for pair: %s"
+)
+
+// addTradeScript appends a trade to the trade tape stream (KEYS[1]) trimmed to about ARGV[1] trades, 0 for no limit,
+// with the fields given in ARGV[4] and after. The trade id and its timestamp (ARGV[3]) are remembered in KEYS[2]
+// for ARGV[2] seconds, a match already remembered there is not added again. Replies with [trade id, timestamp].
+var addTradeScript = redis.NewScript(`
+local recorded = redis.call('HMGET', KEYS[2], 'tradeId', 'timestamp')
+if recorded[1] then
+	return recorded
+end
+local tradeId
+if tonumber(ARGV[1]) > 0 then
+	tradeId = redis.call('XADD', KEYS[1], 'MAXLEN', '~', ARGV[1], '*', unpack(ARGV, 4))
+else
+	tradeId = redis.call('XADD', KEYS[1], '*', unpack(ARGV, 4))
+end
+redis.call('HSET', KEYS[2], 'tradeId', tradeId, 'timestamp', ARGV[3])
+redis.call('EXPIRE', KEYS[2], ARGV[2])
+return {tradeId, ARGV[3]}
+`)
+
+// AddTrade records the match in the capped trade tape of the pair and returns it as TradeEvent.
+// A match of the same book and aggressor orders is recorded only once, a redelivered match
+// returns the trade it was recorded as.
+func (q *QuoteProcessing) AddTrade(matchOrdersEvent *proto.MatchOrdersEvent, tradeTime time.Time) (*proto.TradeEvent, error) {
+	bookOrder, aggressorOrder := matchOrdersEvent.LimitMatchedOrder, matchOrdersEvent.CreatedMatchedOrder
+
+	stringPair := q.Instruments.Name(bookOrder.Pair)
+	instrument, err := q.getInstrument(stringPair)
+	if err != nil {
+		return nil, err
+	}
+	priceTicks, err := getPriceTicks(instrument, bookOrder.InitPrice)
+	if err != nil {
+		return nil, err
+	}
+	volumeSteps, err := getVolumeSteps(instrument, matchOrdersEvent.MatchedVolume)
+	if err != nil {
+		return nil, err
+	}
+
+	trade := &proto.Trade{
+		Pair:             instrument.Id,
+		Price:            instrument.PriceTick.ToFloat(priceTicks),
+		Volume:           instrument.VolumeStep.ToFloat(volumeSteps),
+		AggressorSide:    aggressorOrder.Direction,
+		Timestamp:        tradeTime.UnixMilli(),
+		AggressorOrderId: aggressorOrder.OrderId,
+		BookOrderId:      bookOrder.OrderId,
+	}
+
+	keys := []string{
+		fmt.Sprintf(tradesKey, stringPair),
+		fmt.Sprintf(tradeRecordedKey, stringPair, trade.BookOrderId, trade.AggressorOrderId),
+	}
+	args := []interface{}{
+		q.MaxTradesPerPair, int64(tradeRecordedTtl.Seconds()), trade.Timestamp,
+		"price", priceTicks,
+		"volume", volumeSteps,
+		"aggressorSide", trade.AggressorSide.String(),
+		"timestamp", trade.Timestamp,
+		"aggressorOrderId", trade.AggressorOrderId,
+		"bookOrderId", trade.BookOrderId,
+	}
+	reply, err := addTradeScript.Run(context.Background(), q.RedisClient, keys, args...).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reply) != 2 {
+		return nil, fmt.Errorf(invalidAddTradeReplyErrMsg, stringPair)
+	}
+	trade.TradeId = reply[0]
+	if trade.Timestamp, err = strconv.ParseInt(reply[1], 10, 64); err != nil {
+		return nil, fmt.Errorf(invalidAddTradeReplyErrMsg, stringPair)
+	}
+
+	return &proto.TradeEvent{Trade: trade}, nil
+}
+
+// GetRecentTrades returns up to limit of the latest trades of the pair, newest first.
+func (q *QuoteProcessing) GetRecentTrades(pair string, limit int) ([]*proto.Trade, error) {
+	instrument, err := q.getInstrument(pair)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case limit < 0:
+		return nil, invalidRequestError(invalidTradesLimitErrMsg, limit)
+	case limit == 0:
+		limit = defaultRecentTradesLimit
+	case q.MaxTradesPerPair > 0 && int64(limit) > q.MaxTradesPerPair:
+		limit = int(q.MaxTradesPerPair)
+	}
+
+	messages, err := q.RedisClient.XRevRangeN(context.Background(), fmt.Sprintf(tradesKey, pair), "+", "-", int64(limit)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	trades := make([]*proto.Trade, 0, len(messages))
+	for _, message := range messages {
+		trade, err := parseTrade(instrument, message)
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, trade)
+	}
+
+	return trades, nil
+}
+
+func parseTrade(instrument *models.InstrumentModel, message redis.XMessage) (*proto.Trade, error) {
+	var values [3]int64
+	for i, field := range []string{"price", "volume", "timestamp"} {
+		stringValue, _ := message.Values[field].(string)
+		value, err := strconv.ParseInt(stringValue, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(invalidTradeErrMsg, message.ID, instrument.Pair, field)
+		}
+		values[i] = value
+	}
+
+	stringAggressorSide, _ := message.Values["aggressorSide"].(string)
+	aggressorSide, exists := proto.OrderDirection_value[stringAggressorSide]
+	if !exists {
+		return nil, fmt.Errorf(invalidTradeErrMsg, message.ID, instrument.Pair, "aggressorSide")
+	}
+	aggressorOrderId, _ := message.Values["aggressorOrderId"].(string)
+	bookOrderId, _ := message.Values["bookOrderId"].(string)
+
+	return &proto.Trade{
+		TradeId:          message.ID,
+		Pair:             instrument.Id,
+		Price:            instrument.PriceTick.ToFloat(values[0]),
+		Volume:           instrument.VolumeStep.ToFloat(values[1]),
+		AggressorSide:    proto.OrderDirection(aggressorSide),
+		Timestamp:        values[2],
+		AggressorOrderId: aggressorOrderId,
+		BookOrderId:      bookOrderId,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package processing
+
+import (
+	"QuoteService/proto"
+	"testing"
+	"time"
+)
+
+func TestAddTradeRecordsMatchOnce(t *testing.T) {
+	q := newTestQuoteProcessing(t)
+	matchOrdersEvent := &proto.MatchOrdersEvent{
+		LimitMatchedOrder:   &proto.Order{OrderId: "book", Pair: proto.OrderPair_USD_EUR, Direction: proto.OrderDirection_SELL, InitPrice: 1.0002},
+		CreatedMatchedOrder: &proto.Order{OrderId: "aggressor", Pair: proto.OrderPair_USD_EUR, Direction: proto.OrderDirection_BUY},
+		MatchedVolume:       1.5,
+	}
+	tradeTime := time.UnixMilli(1700000000000)
+
+	tradeEvent, err := q.AddTrade(matchOrdersEvent, tradeTime)
+	if err != nil {
+		t.Fatalf("AddTrade() error = %v", err)
+	}
+	redeliveredTradeEvent, err := q.AddTrade(matchOrdersEvent, tradeTime.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("AddTrade() of the redelivered match error = %v", err)
+	}
+
+	if redeliveredTradeEvent.Trade.TradeId != tradeEvent.Trade.TradeId || redeliveredTradeEvent.Trade.Timestamp != tradeTime.UnixMilli() {
+		t.Errorf("redelivered trade = %v, want %v", redeliveredTradeEvent.Trade, tradeEvent.Trade)
+	}
+
+	trades, err := q.GetRecentTrades(testPair, 0)
+	if err != nil {
+		t.Fatalf("GetRecentTrades() error = %v", err)
+	}
+	if len(trades) != 1 || trades[0].TradeId != tradeEvent.Trade.TradeId || trades[0].Volume != 1.5 || trades[0].Price != 1.0002 {
+		t.Errorf("GetRecentTrades() = %v, want only %v", trades, tradeEvent.Trade)
+	}
+}
@@ -20,12 +20,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CandleInterval int32
+
+const (
+	CandleInterval_MINUTE_1  CandleInterval = 0
+	CandleInterval_MINUTE_5  CandleInterval = 1
+	CandleInterval_MINUTE_15 CandleInterval = 2
+	CandleInterval_HOUR_1    CandleInterval = 3
+	CandleInterval_DAY_1     CandleInterval = 4
+)
+
+// Enum value maps for CandleInterval.
+var (
+	CandleInterval_name = map[int32]string{
+		0: "MINUTE_1",
+		1: "MINUTE_5",
+		2: "MINUTE_15",
+		3: "HOUR_1",
+		4: "DAY_1",
+	}
+	CandleInterval_value = map[string]int32{
+		"MINUTE_1":  0,
+		"MINUTE_5":  1,
+		"MINUTE_15": 2,
+		"HOUR_1":    3,
+		"DAY_1":     4,
+	}
+)
+
+func (x CandleInterval) Enum() *CandleInterval {
+	p := new(CandleInterval)
+	*p = x
+	return p
+}
+
+func (x CandleInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CandleInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_quote_proto_enumTypes[0].Descriptor()
+}
+
+func (CandleInterval) Type() protoreflect.EnumType {
+	return &file_proto_quote_proto_enumTypes[0]
+}
+
+func (x CandleInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CandleInterval.Descriptor instead.
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{0}
+}
+
 type QuotesEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CurrentQuotes []*PairQuote `protobuf:"bytes,1,rep,name=currentQuotes,proto3" json:"currentQuotes,omitempty"`
+	// Quotes of the last trade of every active pair.
+	CurrentQuotes       []*PairQuote          `protobuf:"bytes,1,rep,name=currentQuotes,proto3" json:"currentQuotes,omitempty"`
+	CrossRateDeviations []*CrossRateDeviation `protobuf:"bytes,2,rep,name=crossRateDeviations,proto3" json:"crossRateDeviations,omitempty"`
+	// Synthetic quotes implied from the pairs of the pair currencies with viaCurrency, kept apart from
+	// currentQuotes so consumers of the traded quotes do not see them.
+	CrossRates []*PairQuote `protobuf:"bytes,3,rep,name=crossRates,proto3" json:"crossRates,omitempty"`
 }
 
 func (x *QuotesEvent) Reset() {
@@ -67,14 +127,32 @@ func (x *QuotesEvent) GetCurrentQuotes() []*PairQuote {
 	return nil
 }
 
+func (x *QuotesEvent) GetCrossRateDeviations() []*CrossRateDeviation {
+	if x != nil {
+		return x.CrossRateDeviations
+	}
+	return nil
+}
+
+func (x *QuotesEvent) GetCrossRates() []*PairQuote {
+	if x != nil {
+		return x.CrossRates
+	}
+	return nil
+}
+
 type PairQuote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pair   OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
-	Price  float64   `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	Volume float64   `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Pair          OrderPair       `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	Price         float64         `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Volume        float64         `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	AveragePrices []*AveragePrice `protobuf:"bytes,4,rep,name=averagePrices,proto3" json:"averagePrices,omitempty"`
+	// Set for the crossRates quotes, implied from the pairs of the pair currencies with viaCurrency, their volume is 0.
+	Synthetic   bool   `protobuf:"varint,5,opt,name=synthetic,proto3" json:"synthetic,omitempty"`
+	ViaCurrency string `protobuf:"bytes,6,opt,name=viaCurrency,proto3" json:"viaCurrency,omitempty"`
 }
 
 func (x *PairQuote) Reset() {
@@ -130,16 +208,50 @@ func (x *PairQuote) GetVolume() float64 {
 	return 0
 }
 
-type MarketDepthEvent struct {
+func (x *PairQuote) GetAveragePrices() []*AveragePrice {
+	if x != nil {
+		return x.AveragePrices
+	}
+	return nil
+}
+
+func (x *PairQuote) GetSynthetic() bool {
+	if x != nil {
+		return x.Synthetic
+	}
+	return false
+}
+
+func (x *PairQuote) GetViaCurrency() string {
+	if x != nil {
+		return x.ViaCurrency
+	}
+	return ""
+}
+
+// Real rates of the pair compared to the rates implied from the pairs of its currencies with viaCurrency.
+// Rates missing on either side are 0 and so is their deviation.
+type CrossRateDeviation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MarketDepth []*PairMatketDepth `protobuf:"bytes,1,rep,name=marketDepth,proto3" json:"marketDepth,omitempty"`
+	Pair             OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	ViaCurrency      string    `protobuf:"bytes,2,opt,name=viaCurrency,proto3" json:"viaCurrency,omitempty"`
+	LastPrice        float64   `protobuf:"fixed64,3,opt,name=lastPrice,proto3" json:"lastPrice,omitempty"`
+	ImpliedLastPrice float64   `protobuf:"fixed64,4,opt,name=impliedLastPrice,proto3" json:"impliedLastPrice,omitempty"`
+	// (lastPrice - impliedLastPrice) / impliedLastPrice * 100.
+	LastPriceDeviationPercent float64 `protobuf:"fixed64,5,opt,name=lastPriceDeviationPercent,proto3" json:"lastPriceDeviationPercent,omitempty"`
+	MidPrice                  float64 `protobuf:"fixed64,6,opt,name=midPrice,proto3" json:"midPrice,omitempty"`
+	ImpliedMidPrice           float64 `protobuf:"fixed64,7,opt,name=impliedMidPrice,proto3" json:"impliedMidPrice,omitempty"`
+	MidPriceDeviationPercent  float64 `protobuf:"fixed64,8,opt,name=midPriceDeviationPercent,proto3" json:"midPriceDeviationPercent,omitempty"`
+	// Best bid and ask implied from the top of book of both pairs.
+	ImpliedBid float64 `protobuf:"fixed64,9,opt,name=impliedBid,proto3" json:"impliedBid,omitempty"`
+	ImpliedAsk float64 `protobuf:"fixed64,10,opt,name=impliedAsk,proto3" json:"impliedAsk,omitempty"`
 }
 
-func (x *MarketDepthEvent) Reset() {
-	*x = MarketDepthEvent{}
+func (x *CrossRateDeviation) Reset() {
+	*x = CrossRateDeviation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_quote_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -147,13 +259,13 @@ func (x *MarketDepthEvent) Reset() {
 	}
 }
 
-func (x *MarketDepthEvent) String() string {
+func (x *CrossRateDeviation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketDepthEvent) ProtoMessage() {}
+func (*CrossRateDeviation) ProtoMessage() {}
 
-func (x *MarketDepthEvent) ProtoReflect() protoreflect.Message {
+func (x *CrossRateDeviation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_quote_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -165,30 +277,97 @@ func (x *MarketDepthEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketDepthEvent.ProtoReflect.Descriptor instead.
-func (*MarketDepthEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use CrossRateDeviation.ProtoReflect.Descriptor instead.
+func (*CrossRateDeviation) Descriptor() ([]byte, []int) {
 	return file_proto_quote_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *MarketDepthEvent) GetMarketDepth() []*PairMatketDepth {
+func (x *CrossRateDeviation) GetPair() OrderPair {
 	if x != nil {
-		return x.MarketDepth
+		return x.Pair
 	}
-	return nil
+	return OrderPair_USD_EUR
 }
 
-type PairMatketDepth struct {
+func (x *CrossRateDeviation) GetViaCurrency() string {
+	if x != nil {
+		return x.ViaCurrency
+	}
+	return ""
+}
+
+func (x *CrossRateDeviation) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *CrossRateDeviation) GetImpliedLastPrice() float64 {
+	if x != nil {
+		return x.ImpliedLastPrice
+	}
+	return 0
+}
+
+func (x *CrossRateDeviation) GetLastPriceDeviationPercent() float64 {
+	if x != nil {
+		return x.LastPriceDeviationPercent
+	}
+	return 0
+}
+
+func (x *CrossRateDeviation) GetMidPrice() float64 {
+	if x != nil {
+		return x.MidPrice
+	}
+	return 0
+}
+
+func (x *CrossRateDeviation) GetImpliedMidPrice() float64 {
+	if x != nil {
+		return x.ImpliedMidPrice
+	}
+	return 0
+}
+
+func (x *CrossRateDeviation) GetMidPriceDeviationPercent() float64 {
+	if x != nil {
+		return x.MidPriceDeviationPercent
+	}
+	return 0
+}
+
+func (x *CrossRateDeviation) GetImpliedBid() float64 {
+	if x != nil {
+		return x.ImpliedBid
+	}
+	return 0
+}
+
+func (x *CrossRateDeviation) GetImpliedAsk() float64 {
+	if x != nil {
+		return x.ImpliedAsk
+	}
+	return 0
+}
+
+type AveragePrice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pair          OrderPair        `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
-	Direction     OrderDirection   `protobuf:"varint,2,opt,name=direction,proto3,enum=proto.OrderDirection" json:"direction,omitempty"`
-	VolumeByPrice []*VolumeByPrice `protobuf:"bytes,3,rep,name=volumeByPrice,proto3" json:"volumeByPrice,omitempty"`
+	// Name of the configured window, such as 1m, 1h or session.
+	Window string  `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Vwap   float64 `protobuf:"fixed64,2,opt,name=vwap,proto3" json:"vwap,omitempty"`
+	// Last price sampled once per second for windows up to 10 minutes, once per minute for longer ones.
+	Twap float64 `protobuf:"fixed64,3,opt,name=twap,proto3" json:"twap,omitempty"`
+	// Volume traded in the window, vwap is 0 when nothing was traded.
+	Volume float64 `protobuf:"fixed64,4,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
-func (x *PairMatketDepth) Reset() {
-	*x = PairMatketDepth{}
+func (x *AveragePrice) Reset() {
+	*x = AveragePrice{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_quote_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -196,13 +375,13 @@ func (x *PairMatketDepth) Reset() {
 	}
 }
 
-func (x *PairMatketDepth) String() string {
+func (x *AveragePrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PairMatketDepth) ProtoMessage() {}
+func (*AveragePrice) ProtoMessage() {}
 
-func (x *PairMatketDepth) ProtoReflect() protoreflect.Message {
+func (x *AveragePrice) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_quote_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -214,43 +393,49 @@ func (x *PairMatketDepth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PairMatketDepth.ProtoReflect.Descriptor instead.
-func (*PairMatketDepth) Descriptor() ([]byte, []int) {
+// Deprecated: Use AveragePrice.ProtoReflect.Descriptor instead.
+func (*AveragePrice) Descriptor() ([]byte, []int) {
 	return file_proto_quote_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PairMatketDepth) GetPair() OrderPair {
+func (x *AveragePrice) GetWindow() string {
 	if x != nil {
-		return x.Pair
+		return x.Window
 	}
-	return OrderPair_USD_EUR
+	return ""
 }
 
-func (x *PairMatketDepth) GetDirection() OrderDirection {
+func (x *AveragePrice) GetVwap() float64 {
 	if x != nil {
-		return x.Direction
+		return x.Vwap
 	}
-	return OrderDirection_BUY
+	return 0
 }
 
-func (x *PairMatketDepth) GetVolumeByPrice() []*VolumeByPrice {
+func (x *AveragePrice) GetTwap() float64 {
 	if x != nil {
-		return x.VolumeByPrice
+		return x.Twap
 	}
-	return nil
+	return 0
 }
 
-type VolumeByPrice struct {
+func (x *AveragePrice) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+type GetAveragePricesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Price  float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
-	Volume float64 `protobuf:"fixed64,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Pair OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
 }
 
-func (x *VolumeByPrice) Reset() {
-	*x = VolumeByPrice{}
+func (x *GetAveragePricesRequest) Reset() {
+	*x = GetAveragePricesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_quote_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -258,13 +443,13 @@ func (x *VolumeByPrice) Reset() {
 	}
 }
 
-func (x *VolumeByPrice) String() string {
+func (x *GetAveragePricesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeByPrice) ProtoMessage() {}
+func (*GetAveragePricesRequest) ProtoMessage() {}
 
-func (x *VolumeByPrice) ProtoReflect() protoreflect.Message {
+func (x *GetAveragePricesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_quote_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -276,135 +461,2898 @@ func (x *VolumeByPrice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeByPrice.ProtoReflect.Descriptor instead.
-func (*VolumeByPrice) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAveragePricesRequest.ProtoReflect.Descriptor instead.
+func (*GetAveragePricesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_quote_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *VolumeByPrice) GetPrice() float64 {
+func (x *GetAveragePricesRequest) GetPair() OrderPair {
 	if x != nil {
-		return x.Price
+		return x.Pair
 	}
-	return 0
+	return OrderPair_USD_EUR
 }
 
-func (x *VolumeByPrice) GetVolume() float64 {
-	if x != nil {
-		return x.Volume
+type GetAveragePricesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair          OrderPair       `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	AveragePrices []*AveragePrice `protobuf:"bytes,2,rep,name=averagePrices,proto3" json:"averagePrices,omitempty"`
+	Error         *ErrorDto       `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetAveragePricesResponse) Reset() {
+	*x = GetAveragePricesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-var File_proto_quote_proto protoreflect.FileDescriptor
+func (x *GetAveragePricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_proto_quote_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a,
-	0x0b, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0d,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x69, 0x72,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x09, 0x50, 0x61, 0x69, 0x72, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69,
-	0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44,
-	0x65, 0x70, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x4d, 0x61, 0x74, 0x6b, 0x65,
-	0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65,
-	0x70, 0x74, 0x68, 0x22, 0xa8, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x69, 0x72, 0x4d, 0x61, 0x74, 0x6b,
-	0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x33, 0x0a,
-	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x3d,
-	0x0a, 0x0d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x08, 0x5a,
-	0x06, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*GetAveragePricesResponse) ProtoMessage() {}
+
+func (x *GetAveragePricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_proto_quote_proto_rawDescOnce sync.Once
-	file_proto_quote_proto_rawDescData = file_proto_quote_proto_rawDesc
-)
+// Deprecated: Use GetAveragePricesResponse.ProtoReflect.Descriptor instead.
+func (*GetAveragePricesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{5}
+}
 
-func file_proto_quote_proto_rawDescGZIP() []byte {
-	file_proto_quote_proto_rawDescOnce.Do(func() {
-		file_proto_quote_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_quote_proto_rawDescData)
-	})
-	return file_proto_quote_proto_rawDescData
+func (x *GetAveragePricesResponse) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
 }
 
-var file_proto_quote_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_proto_quote_proto_goTypes = []interface{}{
-	(*QuotesEvent)(nil),      // 0: proto.QuotesEvent
-	(*PairQuote)(nil),        // 1: proto.PairQuote
-	(*MarketDepthEvent)(nil), // 2: proto.MarketDepthEvent
-	(*PairMatketDepth)(nil),  // 3: proto.PairMatketDepth
-	(*VolumeByPrice)(nil),    // 4: proto.VolumeByPrice
-	(OrderPair)(0),           // 5: proto.OrderPair
-	(OrderDirection)(0),      // 6: proto.OrderDirection
+func (x *GetAveragePricesResponse) GetAveragePrices() []*AveragePrice {
+	if x != nil {
+		return x.AveragePrices
+	}
+	return nil
 }
-var file_proto_quote_proto_depIdxs = []int32{
-	1, // 0: proto.QuotesEvent.currentQuotes:type_name -> proto.PairQuote
-	5, // 1: proto.PairQuote.pair:type_name -> proto.OrderPair
-	3, // 2: proto.MarketDepthEvent.marketDepth:type_name -> proto.PairMatketDepth
-	5, // 3: proto.PairMatketDepth.pair:type_name -> proto.OrderPair
-	6, // 4: proto.PairMatketDepth.direction:type_name -> proto.OrderDirection
-	4, // 5: proto.PairMatketDepth.volumeByPrice:type_name -> proto.VolumeByPrice
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+
+func (x *GetAveragePricesResponse) GetError() *ErrorDto {
+	if x != nil {
+		return x.Error
+	}
+	return nil
 }
 
-func init() { file_proto_quote_proto_init() }
-func file_proto_quote_proto_init() {
-	if File_proto_quote_proto != nil {
-		return
+type MarketDepthEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketDepth []*PairMatketDepth `protobuf:"bytes,1,rep,name=marketDepth,proto3" json:"marketDepth,omitempty"`
+}
+
+func (x *MarketDepthEvent) Reset() {
+	*x = MarketDepthEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	file_proto_order_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_proto_quote_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuotesEvent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_quote_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PairQuote); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *MarketDepthEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDepthEvent) ProtoMessage() {}
+
+func (x *MarketDepthEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_quote_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketDepthEvent); i {
-			case 0:
-				return &v.state
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDepthEvent.ProtoReflect.Descriptor instead.
+func (*MarketDepthEvent) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarketDepthEvent) GetMarketDepth() []*PairMatketDepth {
+	if x != nil {
+		return x.MarketDepth
+	}
+	return nil
+}
+
+type MarketDepthDiffEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	// Increases by one for every level change of the pair, a gap means a missed diff.
+	Sequence uint64              `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Levels   []*PriceLevelUpdate `protobuf:"bytes,3,rep,name=levels,proto3" json:"levels,omitempty"`
+}
+
+func (x *MarketDepthDiffEvent) Reset() {
+	*x = MarketDepthDiffEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketDepthDiffEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDepthDiffEvent) ProtoMessage() {}
+
+func (x *MarketDepthDiffEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDepthDiffEvent.ProtoReflect.Descriptor instead.
+func (*MarketDepthDiffEvent) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarketDepthDiffEvent) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *MarketDepthDiffEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MarketDepthDiffEvent) GetLevels() []*PriceLevelUpdate {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type PriceLevelUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Direction OrderDirection `protobuf:"varint,1,opt,name=direction,proto3,enum=proto.OrderDirection" json:"direction,omitempty"`
+	Price     float64        `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// New total volume of the level, 0 means the level was removed.
+	Volume float64 `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *PriceLevelUpdate) Reset() {
+	*x = PriceLevelUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceLevelUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLevelUpdate) ProtoMessage() {}
+
+func (x *PriceLevelUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLevelUpdate.ProtoReflect.Descriptor instead.
+func (*PriceLevelUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PriceLevelUpdate) GetDirection() OrderDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return OrderDirection_BUY
+}
+
+func (x *PriceLevelUpdate) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceLevelUpdate) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+type MarketDepthSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+}
+
+func (x *MarketDepthSnapshotRequest) Reset() {
+	*x = MarketDepthSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketDepthSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDepthSnapshotRequest) ProtoMessage() {}
+
+func (x *MarketDepthSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDepthSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*MarketDepthSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarketDepthSnapshotRequest) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+// Full book of the pair as of the diff with the same sequence: consumers apply
+// only MarketDepthDiffEvent with a greater sequence on top of it.
+type MarketDepthSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair        OrderPair          `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	Sequence    uint64             `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	MarketDepth []*PairMatketDepth `protobuf:"bytes,3,rep,name=marketDepth,proto3" json:"marketDepth,omitempty"`
+	Error       *ErrorDto          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MarketDepthSnapshotResponse) Reset() {
+	*x = MarketDepthSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketDepthSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDepthSnapshotResponse) ProtoMessage() {}
+
+func (x *MarketDepthSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDepthSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*MarketDepthSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MarketDepthSnapshotResponse) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *MarketDepthSnapshotResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MarketDepthSnapshotResponse) GetMarketDepth() []*PairMatketDepth {
+	if x != nil {
+		return x.MarketDepth
+	}
+	return nil
+}
+
+func (x *MarketDepthSnapshotResponse) GetError() *ErrorDto {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type TopOfBookEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	// Best BUY level, not set when there are no bids.
+	BestBid *VolumeByPrice `protobuf:"bytes,2,opt,name=bestBid,proto3" json:"bestBid,omitempty"`
+	// Best SELL level, not set when there are no asks.
+	BestAsk *VolumeByPrice `protobuf:"bytes,3,opt,name=bestAsk,proto3" json:"bestAsk,omitempty"`
+	// Spread and mid price are set only when both sides are present.
+	Spread   float64 `protobuf:"fixed64,4,opt,name=spread,proto3" json:"spread,omitempty"`
+	MidPrice float64 `protobuf:"fixed64,5,opt,name=midPrice,proto3" json:"midPrice,omitempty"`
+}
+
+func (x *TopOfBookEvent) Reset() {
+	*x = TopOfBookEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopOfBookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopOfBookEvent) ProtoMessage() {}
+
+func (x *TopOfBookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopOfBookEvent.ProtoReflect.Descriptor instead.
+func (*TopOfBookEvent) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TopOfBookEvent) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *TopOfBookEvent) GetBestBid() *VolumeByPrice {
+	if x != nil {
+		return x.BestBid
+	}
+	return nil
+}
+
+func (x *TopOfBookEvent) GetBestAsk() *VolumeByPrice {
+	if x != nil {
+		return x.BestAsk
+	}
+	return nil
+}
+
+func (x *TopOfBookEvent) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *TopOfBookEvent) GetMidPrice() float64 {
+	if x != nil {
+		return x.MidPrice
+	}
+	return 0
+}
+
+// Published once per pair and interval when a candle with at least one trade closes.
+type CandleEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair     OrderPair      `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	Interval CandleInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=proto.CandleInterval" json:"interval,omitempty"`
+	Candle   *Candle        `protobuf:"bytes,3,opt,name=candle,proto3" json:"candle,omitempty"`
+}
+
+func (x *CandleEvent) Reset() {
+	*x = CandleEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CandleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandleEvent) ProtoMessage() {}
+
+func (x *CandleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandleEvent.ProtoReflect.Descriptor instead.
+func (*CandleEvent) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CandleEvent) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *CandleEvent) GetInterval() CandleInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return CandleInterval_MINUTE_1
+}
+
+func (x *CandleEvent) GetCandle() *Candle {
+	if x != nil {
+		return x.Candle
+	}
+	return nil
+}
+
+type Candle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix milliseconds, openTime is inclusive and closeTime exclusive,
+	// both aligned to the interval in UTC.
+	OpenTime   int64   `protobuf:"varint,1,opt,name=openTime,proto3" json:"openTime,omitempty"`
+	CloseTime  int64   `protobuf:"varint,2,opt,name=closeTime,proto3" json:"closeTime,omitempty"`
+	Open       float64 `protobuf:"fixed64,3,opt,name=open,proto3" json:"open,omitempty"`
+	High       float64 `protobuf:"fixed64,4,opt,name=high,proto3" json:"high,omitempty"`
+	Low        float64 `protobuf:"fixed64,5,opt,name=low,proto3" json:"low,omitempty"`
+	Close      float64 `protobuf:"fixed64,6,opt,name=close,proto3" json:"close,omitempty"`
+	Volume     float64 `protobuf:"fixed64,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	TradeCount uint64  `protobuf:"varint,8,opt,name=tradeCount,proto3" json:"tradeCount,omitempty"`
+	// Sum of price times volume of the trades.
+	QuoteVolume float64 `protobuf:"fixed64,9,opt,name=quoteVolume,proto3" json:"quoteVolume,omitempty"`
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Candle) GetOpenTime() int64 {
+	if x != nil {
+		return x.OpenTime
+	}
+	return 0
+}
+
+func (x *Candle) GetCloseTime() int64 {
+	if x != nil {
+		return x.CloseTime
+	}
+	return 0
+}
+
+func (x *Candle) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Candle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Candle) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Candle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Candle) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Candle) GetTradeCount() uint64 {
+	if x != nil {
+		return x.TradeCount
+	}
+	return 0
+}
+
+func (x *Candle) GetQuoteVolume() float64 {
+	if x != nil {
+		return x.QuoteVolume
+	}
+	return 0
+}
+
+// Requests candles of the pair and interval with openTime in [fromTime, toTime), oldest first.
+type GetCandlesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair     OrderPair      `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	Interval CandleInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=proto.CandleInterval" json:"interval,omitempty"`
+	// Unix milliseconds.
+	FromTime int64 `protobuf:"varint,3,opt,name=fromTime,proto3" json:"fromTime,omitempty"`
+	ToTime   int64 `protobuf:"varint,4,opt,name=toTime,proto3" json:"toTime,omitempty"`
+	// Maximum number of candles in the response, 0 means the default page size.
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetCandlesRequest) Reset() {
+	*x = GetCandlesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesRequest) ProtoMessage() {}
+
+func (x *GetCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCandlesRequest) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *GetCandlesRequest) GetInterval() CandleInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return CandleInterval_MINUTE_1
+}
+
+func (x *GetCandlesRequest) GetFromTime() int64 {
+	if x != nil {
+		return x.FromTime
+	}
+	return 0
+}
+
+func (x *GetCandlesRequest) GetToTime() int64 {
+	if x != nil {
+		return x.ToTime
+	}
+	return 0
+}
+
+func (x *GetCandlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCandlesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair     OrderPair      `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	Interval CandleInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=proto.CandleInterval" json:"interval,omitempty"`
+	Candles  []*Candle      `protobuf:"bytes,3,rep,name=candles,proto3" json:"candles,omitempty"`
+	// Set when the range has more candles than the limit: request the next page
+	// with it as fromTime and the same toTime. 0 on the last page.
+	NextFromTime int64     `protobuf:"varint,4,opt,name=nextFromTime,proto3" json:"nextFromTime,omitempty"`
+	Error        *ErrorDto `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetCandlesResponse) Reset() {
+	*x = GetCandlesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesResponse) ProtoMessage() {}
+
+func (x *GetCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCandlesResponse) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *GetCandlesResponse) GetInterval() CandleInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return CandleInterval_MINUTE_1
+}
+
+func (x *GetCandlesResponse) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *GetCandlesResponse) GetNextFromTime() int64 {
+	if x != nil {
+		return x.NextFromTime
+	}
+	return 0
+}
+
+func (x *GetCandlesResponse) GetError() *ErrorDto {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Rolling statistics of the pair over the last 24 hours, with minute precision.
+type TickerEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair      OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	LastPrice float64   `protobuf:"fixed64,2,opt,name=lastPrice,proto3" json:"lastPrice,omitempty"`
+	// Price of the first trade in the window, the last price when there were no trades.
+	OpenPrice float64 `protobuf:"fixed64,3,opt,name=openPrice,proto3" json:"openPrice,omitempty"`
+	HighPrice float64 `protobuf:"fixed64,4,opt,name=highPrice,proto3" json:"highPrice,omitempty"`
+	LowPrice  float64 `protobuf:"fixed64,5,opt,name=lowPrice,proto3" json:"lowPrice,omitempty"`
+	Volume    float64 `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	// Sum of price times volume of the trades.
+	QuoteVolume float64 `protobuf:"fixed64,7,opt,name=quoteVolume,proto3" json:"quoteVolume,omitempty"`
+	// lastPrice - openPrice, and the same as percent of openPrice.
+	PriceChange        float64 `protobuf:"fixed64,8,opt,name=priceChange,proto3" json:"priceChange,omitempty"`
+	PriceChangePercent float64 `protobuf:"fixed64,9,opt,name=priceChangePercent,proto3" json:"priceChangePercent,omitempty"`
+	TradeCount         uint64  `protobuf:"varint,10,opt,name=tradeCount,proto3" json:"tradeCount,omitempty"`
+}
+
+func (x *TickerEvent) Reset() {
+	*x = TickerEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TickerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickerEvent) ProtoMessage() {}
+
+func (x *TickerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickerEvent.ProtoReflect.Descriptor instead.
+func (*TickerEvent) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TickerEvent) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *TickerEvent) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *TickerEvent) GetOpenPrice() float64 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *TickerEvent) GetHighPrice() float64 {
+	if x != nil {
+		return x.HighPrice
+	}
+	return 0
+}
+
+func (x *TickerEvent) GetLowPrice() float64 {
+	if x != nil {
+		return x.LowPrice
+	}
+	return 0
+}
+
+func (x *TickerEvent) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *TickerEvent) GetQuoteVolume() float64 {
+	if x != nil {
+		return x.QuoteVolume
+	}
+	return 0
+}
+
+func (x *TickerEvent) GetPriceChange() float64 {
+	if x != nil {
+		return x.PriceChange
+	}
+	return 0
+}
+
+func (x *TickerEvent) GetPriceChangePercent() float64 {
+	if x != nil {
+		return x.PriceChangePercent
+	}
+	return 0
+}
+
+func (x *TickerEvent) GetTradeCount() uint64 {
+	if x != nil {
+		return x.TradeCount
+	}
+	return 0
+}
+
+// Round trip through three pairs at their best bid and ask that ends with more of currency than it started with after fees.
+type ArbitrageOpportunityEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Currency the round trip starts and ends in, size and profit are in it.
+	Currency string          `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Legs     []*ArbitrageLeg `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	// Largest amount the best levels of all legs can take.
+	Size          float64 `protobuf:"fixed64,3,opt,name=size,proto3" json:"size,omitempty"`
+	Profit        float64 `protobuf:"fixed64,4,opt,name=profit,proto3" json:"profit,omitempty"`
+	ProfitPercent float64 `protobuf:"fixed64,5,opt,name=profitPercent,proto3" json:"profitPercent,omitempty"`
+}
+
+func (x *ArbitrageOpportunityEvent) Reset() {
+	*x = ArbitrageOpportunityEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArbitrageOpportunityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArbitrageOpportunityEvent) ProtoMessage() {}
+
+func (x *ArbitrageOpportunityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArbitrageOpportunityEvent.ProtoReflect.Descriptor instead.
+func (*ArbitrageOpportunityEvent) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ArbitrageOpportunityEvent) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunityEvent) GetLegs() []*ArbitrageLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *ArbitrageOpportunityEvent) GetSize() float64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunityEvent) GetProfit() float64 {
+	if x != nil {
+		return x.Profit
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunityEvent) GetProfitPercent() float64 {
+	if x != nil {
+		return x.ProfitPercent
+	}
+	return 0
+}
+
+type ArbitrageLeg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	// BUY takes the best ask of the pair, SELL the best bid.
+	Direction OrderDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=proto.OrderDirection" json:"direction,omitempty"`
+	Price     float64        `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Volume of the leg in the base currency of the pair for the opportunity size.
+	Volume       float64 `protobuf:"fixed64,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	FromCurrency string  `protobuf:"bytes,5,opt,name=fromCurrency,proto3" json:"fromCurrency,omitempty"`
+	ToCurrency   string  `protobuf:"bytes,6,opt,name=toCurrency,proto3" json:"toCurrency,omitempty"`
+}
+
+func (x *ArbitrageLeg) Reset() {
+	*x = ArbitrageLeg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArbitrageLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArbitrageLeg) ProtoMessage() {}
+
+func (x *ArbitrageLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArbitrageLeg.ProtoReflect.Descriptor instead.
+func (*ArbitrageLeg) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ArbitrageLeg) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *ArbitrageLeg) GetDirection() OrderDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return OrderDirection_BUY
+}
+
+func (x *ArbitrageLeg) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ArbitrageLeg) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *ArbitrageLeg) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *ArbitrageLeg) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+// Book health of the pair after a depth update.
+type LiquidityMetricsEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair      OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	BidLevels uint32    `protobuf:"varint,2,opt,name=bidLevels,proto3" json:"bidLevels,omitempty"`
+	AskLevels uint32    `protobuf:"varint,3,opt,name=askLevels,proto3" json:"askLevels,omitempty"`
+	// Total volume of each side of the book.
+	BidVolume float64 `protobuf:"fixed64,4,opt,name=bidVolume,proto3" json:"bidVolume,omitempty"`
+	AskVolume float64 `protobuf:"fixed64,5,opt,name=askVolume,proto3" json:"askVolume,omitempty"`
+	// Spread and mid price are set only when both sides are present.
+	SpreadTicks int64                 `protobuf:"varint,6,opt,name=spreadTicks,proto3" json:"spreadTicks,omitempty"`
+	MidPrice    float64               `protobuf:"fixed64,7,opt,name=midPrice,proto3" json:"midPrice,omitempty"`
+	DepthBands  []*LiquidityDepthBand `protobuf:"bytes,8,rep,name=depthBands,proto3" json:"depthBands,omitempty"`
+}
+
+func (x *LiquidityMetricsEvent) Reset() {
+	*x = LiquidityMetricsEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityMetricsEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityMetricsEvent) ProtoMessage() {}
+
+func (x *LiquidityMetricsEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityMetricsEvent.ProtoReflect.Descriptor instead.
+func (*LiquidityMetricsEvent) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LiquidityMetricsEvent) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *LiquidityMetricsEvent) GetBidLevels() uint32 {
+	if x != nil {
+		return x.BidLevels
+	}
+	return 0
+}
+
+func (x *LiquidityMetricsEvent) GetAskLevels() uint32 {
+	if x != nil {
+		return x.AskLevels
+	}
+	return 0
+}
+
+func (x *LiquidityMetricsEvent) GetBidVolume() float64 {
+	if x != nil {
+		return x.BidVolume
+	}
+	return 0
+}
+
+func (x *LiquidityMetricsEvent) GetAskVolume() float64 {
+	if x != nil {
+		return x.AskVolume
+	}
+	return 0
+}
+
+func (x *LiquidityMetricsEvent) GetSpreadTicks() int64 {
+	if x != nil {
+		return x.SpreadTicks
+	}
+	return 0
+}
+
+func (x *LiquidityMetricsEvent) GetMidPrice() float64 {
+	if x != nil {
+		return x.MidPrice
+	}
+	return 0
+}
+
+func (x *LiquidityMetricsEvent) GetDepthBands() []*LiquidityDepthBand {
+	if x != nil {
+		return x.DepthBands
+	}
+	return nil
+}
+
+type LiquidityDepthBand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Distance from the mid price in percent the volumes of both sides are summed within.
+	Percent   float64 `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	BidVolume float64 `protobuf:"fixed64,2,opt,name=bidVolume,proto3" json:"bidVolume,omitempty"`
+	AskVolume float64 `protobuf:"fixed64,3,opt,name=askVolume,proto3" json:"askVolume,omitempty"`
+	// (bidVolume - askVolume) / (bidVolume + askVolume), from -1 with only asks to 1 with only bids.
+	Imbalance float64 `protobuf:"fixed64,4,opt,name=imbalance,proto3" json:"imbalance,omitempty"`
+}
+
+func (x *LiquidityDepthBand) Reset() {
+	*x = LiquidityDepthBand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityDepthBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityDepthBand) ProtoMessage() {}
+
+func (x *LiquidityDepthBand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityDepthBand.ProtoReflect.Descriptor instead.
+func (*LiquidityDepthBand) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LiquidityDepthBand) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *LiquidityDepthBand) GetBidVolume() float64 {
+	if x != nil {
+		return x.BidVolume
+	}
+	return 0
+}
+
+func (x *LiquidityDepthBand) GetAskVolume() float64 {
+	if x != nil {
+		return x.AskVolume
+	}
+	return 0
+}
+
+func (x *LiquidityDepthBand) GetImbalance() float64 {
+	if x != nil {
+		return x.Imbalance
+	}
+	return 0
+}
+
+type TradeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trade *Trade `protobuf:"bytes,1,opt,name=trade,proto3" json:"trade,omitempty"`
+}
+
+func (x *TradeEvent) Reset() {
+	*x = TradeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeEvent) ProtoMessage() {}
+
+func (x *TradeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeEvent.ProtoReflect.Descriptor instead.
+func (*TradeEvent) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TradeEvent) GetTrade() *Trade {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+type Trade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the trade in the trade tape of the pair, increasing with time.
+	TradeId string    `protobuf:"bytes,1,opt,name=tradeId,proto3" json:"tradeId,omitempty"`
+	Pair    OrderPair `protobuf:"varint,2,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	Price   float64   `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Volume  float64   `protobuf:"fixed64,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	// Direction of the incoming order that matched against the book.
+	AggressorSide OrderDirection `protobuf:"varint,5,opt,name=aggressorSide,proto3,enum=proto.OrderDirection" json:"aggressorSide,omitempty"`
+	// Unix milliseconds.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Ids of the incoming order and of the book order it matched.
+	AggressorOrderId string `protobuf:"bytes,7,opt,name=aggressorOrderId,proto3" json:"aggressorOrderId,omitempty"`
+	BookOrderId      string `protobuf:"bytes,8,opt,name=bookOrderId,proto3" json:"bookOrderId,omitempty"`
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Trade) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *Trade) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *Trade) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Trade) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Trade) GetAggressorSide() OrderDirection {
+	if x != nil {
+		return x.AggressorSide
+	}
+	return OrderDirection_BUY
+}
+
+func (x *Trade) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Trade) GetAggressorOrderId() string {
+	if x != nil {
+		return x.AggressorOrderId
+	}
+	return ""
+}
+
+func (x *Trade) GetBookOrderId() string {
+	if x != nil {
+		return x.BookOrderId
+	}
+	return ""
+}
+
+type GetRecentTradesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	// Maximum number of trades in the response, 0 means the default.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetRecentTradesRequest) Reset() {
+	*x = GetRecentTradesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentTradesRequest) ProtoMessage() {}
+
+func (x *GetRecentTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentTradesRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentTradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetRecentTradesRequest) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *GetRecentTradesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRecentTradesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	// Newest trade first.
+	Trades []*Trade  `protobuf:"bytes,2,rep,name=trades,proto3" json:"trades,omitempty"`
+	Error  *ErrorDto `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetRecentTradesResponse) Reset() {
+	*x = GetRecentTradesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentTradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentTradesResponse) ProtoMessage() {}
+
+func (x *GetRecentTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentTradesResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentTradesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetRecentTradesResponse) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *GetRecentTradesResponse) GetTrades() []*Trade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+func (x *GetRecentTradesResponse) GetError() *ErrorDto {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type EstimateFillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair OrderPair `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	// Direction of the MARKET order, BUY fills against the SELL side of the book.
+	Direction OrderDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=proto.OrderDirection" json:"direction,omitempty"`
+	Volume    float64        `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *EstimateFillRequest) Reset() {
+	*x = EstimateFillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateFillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFillRequest) ProtoMessage() {}
+
+func (x *EstimateFillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateFillRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFillRequest) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EstimateFillRequest) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *EstimateFillRequest) GetDirection() OrderDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return OrderDirection_BUY
+}
+
+func (x *EstimateFillRequest) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+// Fill of a MARKET order against the current book, without fees.
+type EstimateFillResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair         OrderPair      `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	Direction    OrderDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=proto.OrderDirection" json:"direction,omitempty"`
+	FilledVolume float64        `protobuf:"fixed64,3,opt,name=filledVolume,proto3" json:"filledVolume,omitempty"`
+	// Volume the book does not have enough liquidity for.
+	UnfilledVolume float64 `protobuf:"fixed64,4,opt,name=unfilledVolume,proto3" json:"unfilledVolume,omitempty"`
+	AveragePrice   float64 `protobuf:"fixed64,5,opt,name=averagePrice,proto3" json:"averagePrice,omitempty"`
+	// Price of the last level the order reaches.
+	WorstPrice     float64 `protobuf:"fixed64,6,opt,name=worstPrice,proto3" json:"worstPrice,omitempty"`
+	LevelsConsumed uint32  `protobuf:"varint,7,opt,name=levelsConsumed,proto3" json:"levelsConsumed,omitempty"`
+	// Quote currency amount of the filled volume.
+	Cost     float64 `protobuf:"fixed64,8,opt,name=cost,proto3" json:"cost,omitempty"`
+	MidPrice float64 `protobuf:"fixed64,9,opt,name=midPrice,proto3" json:"midPrice,omitempty"`
+	// How much worse than midPrice the average price is, in percent. 0 when one side of the book is empty.
+	PriceImpactPercent float64   `protobuf:"fixed64,10,opt,name=priceImpactPercent,proto3" json:"priceImpactPercent,omitempty"`
+	Error              *ErrorDto `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EstimateFillResponse) Reset() {
+	*x = EstimateFillResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateFillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFillResponse) ProtoMessage() {}
+
+func (x *EstimateFillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateFillResponse.ProtoReflect.Descriptor instead.
+func (*EstimateFillResponse) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EstimateFillResponse) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *EstimateFillResponse) GetDirection() OrderDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return OrderDirection_BUY
+}
+
+func (x *EstimateFillResponse) GetFilledVolume() float64 {
+	if x != nil {
+		return x.FilledVolume
+	}
+	return 0
+}
+
+func (x *EstimateFillResponse) GetUnfilledVolume() float64 {
+	if x != nil {
+		return x.UnfilledVolume
+	}
+	return 0
+}
+
+func (x *EstimateFillResponse) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *EstimateFillResponse) GetWorstPrice() float64 {
+	if x != nil {
+		return x.WorstPrice
+	}
+	return 0
+}
+
+func (x *EstimateFillResponse) GetLevelsConsumed() uint32 {
+	if x != nil {
+		return x.LevelsConsumed
+	}
+	return 0
+}
+
+func (x *EstimateFillResponse) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *EstimateFillResponse) GetMidPrice() float64 {
+	if x != nil {
+		return x.MidPrice
+	}
+	return 0
+}
+
+func (x *EstimateFillResponse) GetPriceImpactPercent() float64 {
+	if x != nil {
+		return x.PriceImpactPercent
+	}
+	return 0
+}
+
+func (x *EstimateFillResponse) GetError() *ErrorDto {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// A message a listener could not process, moved to the dead letter queue of its queue.
+type DeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Exchange and routing key the message was first published with.
+	Exchange   string `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	RoutingKey string `protobuf:"bytes,2,opt,name=routingKey,proto3" json:"routingKey,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Retries    uint32 `protobuf:"varint,4,opt,name=retries,proto3" json:"retries,omitempty"`
+	Body       []byte `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeadLetter) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type GetDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Listener queue whose dead letter queue is read, e.g. q.QuoteService.CreateOrderResponse.Listener.
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Default 100, at most 1000.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetDeadLettersRequest) Reset() {
+	*x = GetDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLettersRequest) ProtoMessage() {}
+
+func (x *GetDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetDeadLettersRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *GetDeadLettersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Oldest message first, the messages stay in the dead letter queue.
+	DeadLetters []*DeadLetter `protobuf:"bytes,2,rep,name=deadLetters,proto3" json:"deadLetters,omitempty"`
+	Error       *ErrorDto     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetDeadLettersResponse) Reset() {
+	*x = GetDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLettersResponse) ProtoMessage() {}
+
+func (x *GetDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetDeadLettersResponse) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *GetDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *GetDeadLettersResponse) GetError() *ErrorDto {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ReplayDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Default 100, at most 1000.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReplayDeadLettersRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ReplayDeadLettersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Number of messages moved back to the queue.
+	Replayed uint32    `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	Error    *ErrorDto `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReplayDeadLettersResponse) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ReplayDeadLettersResponse) GetReplayed() uint32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+func (x *ReplayDeadLettersResponse) GetError() *ErrorDto {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetMarketDepthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMarketDepthRequest) Reset() {
+	*x = GetMarketDepthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketDepthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketDepthRequest) ProtoMessage() {}
+
+func (x *GetMarketDepthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketDepthRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketDepthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{32}
+}
+
+type GetMarketDepthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketDepth []*PairMatketDepth `protobuf:"bytes,1,rep,name=marketDepth,proto3" json:"marketDepth,omitempty"`
+	Error       *ErrorDto          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetMarketDepthResponse) Reset() {
+	*x = GetMarketDepthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketDepthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketDepthResponse) ProtoMessage() {}
+
+func (x *GetMarketDepthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketDepthResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketDepthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetMarketDepthResponse) GetMarketDepth() []*PairMatketDepth {
+	if x != nil {
+		return x.MarketDepth
+	}
+	return nil
+}
+
+func (x *GetMarketDepthResponse) GetError() *ErrorDto {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type PairMatketDepth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair      OrderPair      `protobuf:"varint,1,opt,name=pair,proto3,enum=proto.OrderPair" json:"pair,omitempty"`
+	Direction OrderDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=proto.OrderDirection" json:"direction,omitempty"`
+	// Levels are always ordered best price first:
+	// BUY from the highest price down, SELL from the lowest price up.
+	VolumeByPrice []*VolumeByPrice `protobuf:"bytes,3,rep,name=volumeByPrice,proto3" json:"volumeByPrice,omitempty"`
+}
+
+func (x *PairMatketDepth) Reset() {
+	*x = PairMatketDepth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairMatketDepth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairMatketDepth) ProtoMessage() {}
+
+func (x *PairMatketDepth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairMatketDepth.ProtoReflect.Descriptor instead.
+func (*PairMatketDepth) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PairMatketDepth) GetPair() OrderPair {
+	if x != nil {
+		return x.Pair
+	}
+	return OrderPair_USD_EUR
+}
+
+func (x *PairMatketDepth) GetDirection() OrderDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return OrderDirection_BUY
+}
+
+func (x *PairMatketDepth) GetVolumeByPrice() []*VolumeByPrice {
+	if x != nil {
+		return x.VolumeByPrice
+	}
+	return nil
+}
+
+type VolumeByPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price  float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Volume float64 `protobuf:"fixed64,2,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *VolumeByPrice) Reset() {
+	*x = VolumeByPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_quote_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeByPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeByPrice) ProtoMessage() {}
+
+func (x *VolumeByPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_quote_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeByPrice.ProtoReflect.Descriptor instead.
+func (*VolumeByPrice) Descriptor() ([]byte, []int) {
+	return file_proto_quote_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VolumeByPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *VolumeByPrice) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+var File_proto_quote_proto protoreflect.FileDescriptor
+
+var file_proto_quote_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xc4, 0x01, 0x0a, 0x0b, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x36, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x52, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x6f, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x13, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x72, 0x6f,
+	0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x69, 0x72,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x74, 0x69,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x74,
+	0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x69, 0x61, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x69, 0x61, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0xa6, 0x03, 0x0a, 0x12, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x52, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x70,
+	0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x69, 0x61, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x69, 0x61, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a,
+	0x19, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x19, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d,
+	0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x4d, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x4d, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x3a, 0x0a, 0x18, 0x6d, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x18, 0x6d, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x42, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x42, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41, 0x73, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41, 0x73, 0x6b, 0x22, 0x66, 0x0a,
+	0x0c, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x77, 0x61, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x77, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x77, 0x61,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x74, 0x77, 0x61, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x22, 0xa2, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x39, 0x0a, 0x0d, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x44, 0x74, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x10, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x38, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x69,
+	0x72, 0x4d, 0x61, 0x74, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x0b, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x44, 0x69, 0x66, 0x66, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x75, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x1a,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61,
+	0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x22, 0xc0, 0x01, 0x0a, 0x1b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x4d, 0x61, 0x74, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52,
+	0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x74, 0x6f, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xca, 0x01, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x4f, 0x66, 0x42, 0x6f, 0x6f,
+	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x2e, 0x0a, 0x07,
+	0x62, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x07, 0x62, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x07,
+	0x62, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x07, 0x62, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x70,
+	0x72, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x06, 0x63, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x22, 0xec, 0x01, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22,
+	0xb6, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xe1, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52,
+	0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x44, 0x74, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd5, 0x02, 0x0a,
+	0x0b, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04,
+	0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61,
+	0x69, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x12, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x19, 0x41, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61,
+	0x67, 0x65, 0x4f, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x27,
+	0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x65,
+	0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x0c, 0x41, 0x72,
+	0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x67, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61,
+	0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x12, 0x33, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xae, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x69, 0x64, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x73, 0x6b, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x69, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x73, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x63, 0x6b,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x64, 0x65, 0x70, 0x74, 0x68, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x44, 0x65, 0x70, 0x74, 0x68, 0x42, 0x61, 0x6e, 0x64, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x44, 0x65, 0x70, 0x74, 0x68, 0x42, 0x61, 0x6e, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69, 0x64,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x69,
+	0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x73, 0x6b, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x22, 0x30, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x22, 0x9e, 0x02, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x3b, 0x0a,
+	0x0d, 0x61, 0x67, 0x67, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x69, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x69, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x6b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8c, 0x01, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x24,
+	0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x44, 0x74, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x13,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x33, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0xb0, 0x03, 0x0a, 0x14, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52,
+	0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x33, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x6f,
+	0x72, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x77, 0x6f, 0x72, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44,
+	0x74, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x0a, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x43, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x8a, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x44, 0x74, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x46, 0x0a, 0x18,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x74, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x44, 0x74, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x4d,
+	0x61, 0x74, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x44, 0x74, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa8,
+	0x01, 0x0a, 0x0f, 0x50, 0x61, 0x69, 0x72, 0x4d, 0x61, 0x74, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x33, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a,
+	0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x42, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0d, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x42, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x3d, 0x0a, 0x0d, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x42, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2a, 0x52, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x49,
+	0x4e, 0x55, 0x54, 0x45, 0x5f, 0x31, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x49, 0x4e, 0x55,
+	0x54, 0x45, 0x5f, 0x35, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45,
+	0x5f, 0x31, 0x35, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x4f, 0x55, 0x52, 0x5f, 0x31, 0x10,
+	0x03, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x41, 0x59, 0x5f, 0x31, 0x10, 0x04, 0x42, 0x08, 0x5a, 0x06,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_quote_proto_rawDescOnce sync.Once
+	file_proto_quote_proto_rawDescData = file_proto_quote_proto_rawDesc
+)
+
+func file_proto_quote_proto_rawDescGZIP() []byte {
+	file_proto_quote_proto_rawDescOnce.Do(func() {
+		file_proto_quote_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_quote_proto_rawDescData)
+	})
+	return file_proto_quote_proto_rawDescData
+}
+
+var file_proto_quote_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_quote_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_proto_quote_proto_goTypes = []interface{}{
+	(CandleInterval)(0),                 // 0: proto.CandleInterval
+	(*QuotesEvent)(nil),                 // 1: proto.QuotesEvent
+	(*PairQuote)(nil),                   // 2: proto.PairQuote
+	(*CrossRateDeviation)(nil),          // 3: proto.CrossRateDeviation
+	(*AveragePrice)(nil),                // 4: proto.AveragePrice
+	(*GetAveragePricesRequest)(nil),     // 5: proto.GetAveragePricesRequest
+	(*GetAveragePricesResponse)(nil),    // 6: proto.GetAveragePricesResponse
+	(*MarketDepthEvent)(nil),            // 7: proto.MarketDepthEvent
+	(*MarketDepthDiffEvent)(nil),        // 8: proto.MarketDepthDiffEvent
+	(*PriceLevelUpdate)(nil),            // 9: proto.PriceLevelUpdate
+	(*MarketDepthSnapshotRequest)(nil),  // 10: proto.MarketDepthSnapshotRequest
+	(*MarketDepthSnapshotResponse)(nil), // 11: proto.MarketDepthSnapshotResponse
+	(*TopOfBookEvent)(nil),              // 12: proto.TopOfBookEvent
+	(*CandleEvent)(nil),                 // 13: proto.CandleEvent
+	(*Candle)(nil),                      // 14: proto.Candle
+	(*GetCandlesRequest)(nil),           // 15: proto.GetCandlesRequest
+	(*GetCandlesResponse)(nil),          // 16: proto.GetCandlesResponse
+	(*TickerEvent)(nil),                 // 17: proto.TickerEvent
+	(*ArbitrageOpportunityEvent)(nil),   // 18: proto.ArbitrageOpportunityEvent
+	(*ArbitrageLeg)(nil),                // 19: proto.ArbitrageLeg
+	(*LiquidityMetricsEvent)(nil),       // 20: proto.LiquidityMetricsEvent
+	(*LiquidityDepthBand)(nil),          // 21: proto.LiquidityDepthBand
+	(*TradeEvent)(nil),                  // 22: proto.TradeEvent
+	(*Trade)(nil),                       // 23: proto.Trade
+	(*GetRecentTradesRequest)(nil),      // 24: proto.GetRecentTradesRequest
+	(*GetRecentTradesResponse)(nil),     // 25: proto.GetRecentTradesResponse
+	(*EstimateFillRequest)(nil),         // 26: proto.EstimateFillRequest
+	(*EstimateFillResponse)(nil),        // 27: proto.EstimateFillResponse
+	(*DeadLetter)(nil),                  // 28: proto.DeadLetter
+	(*GetDeadLettersRequest)(nil),       // 29: proto.GetDeadLettersRequest
+	(*GetDeadLettersResponse)(nil),      // 30: proto.GetDeadLettersResponse
+	(*ReplayDeadLettersRequest)(nil),    // 31: proto.ReplayDeadLettersRequest
+	(*ReplayDeadLettersResponse)(nil),   // 32: proto.ReplayDeadLettersResponse
+	(*GetMarketDepthRequest)(nil),       // 33: proto.GetMarketDepthRequest
+	(*GetMarketDepthResponse)(nil),      // 34: proto.GetMarketDepthResponse
+	(*PairMatketDepth)(nil),             // 35: proto.PairMatketDepth
+	(*VolumeByPrice)(nil),               // 36: proto.VolumeByPrice
+	(OrderPair)(0),                      // 37: proto.OrderPair
+	(*ErrorDto)(nil),                    // 38: proto.ErrorDto
+	(OrderDirection)(0),                 // 39: proto.OrderDirection
+}
+var file_proto_quote_proto_depIdxs = []int32{
+	2,  // 0: proto.QuotesEvent.currentQuotes:type_name -> proto.PairQuote
+	3,  // 1: proto.QuotesEvent.crossRateDeviations:type_name -> proto.CrossRateDeviation
+	2,  // 2: proto.QuotesEvent.crossRates:type_name -> proto.PairQuote
+	37, // 3: proto.PairQuote.pair:type_name -> proto.OrderPair
+	4,  // 4: proto.PairQuote.averagePrices:type_name -> proto.AveragePrice
+	37, // 5: proto.CrossRateDeviation.pair:type_name -> proto.OrderPair
+	37, // 6: proto.GetAveragePricesRequest.pair:type_name -> proto.OrderPair
+	37, // 7: proto.GetAveragePricesResponse.pair:type_name -> proto.OrderPair
+	4,  // 8: proto.GetAveragePricesResponse.averagePrices:type_name -> proto.AveragePrice
+	38, // 9: proto.GetAveragePricesResponse.error:type_name -> proto.ErrorDto
+	35, // 10: proto.MarketDepthEvent.marketDepth:type_name -> proto.PairMatketDepth
+	37, // 11: proto.MarketDepthDiffEvent.pair:type_name -> proto.OrderPair
+	9,  // 12: proto.MarketDepthDiffEvent.levels:type_name -> proto.PriceLevelUpdate
+	39, // 13: proto.PriceLevelUpdate.direction:type_name -> proto.OrderDirection
+	37, // 14: proto.MarketDepthSnapshotRequest.pair:type_name -> proto.OrderPair
+	37, // 15: proto.MarketDepthSnapshotResponse.pair:type_name -> proto.OrderPair
+	35, // 16: proto.MarketDepthSnapshotResponse.marketDepth:type_name -> proto.PairMatketDepth
+	38, // 17: proto.MarketDepthSnapshotResponse.error:type_name -> proto.ErrorDto
+	37, // 18: proto.TopOfBookEvent.pair:type_name -> proto.OrderPair
+	36, // 19: proto.TopOfBookEvent.bestBid:type_name -> proto.VolumeByPrice
+	36, // 20: proto.TopOfBookEvent.bestAsk:type_name -> proto.VolumeByPrice
+	37, // 21: proto.CandleEvent.pair:type_name -> proto.OrderPair
+	0,  // 22: proto.CandleEvent.interval:type_name -> proto.CandleInterval
+	14, // 23: proto.CandleEvent.candle:type_name -> proto.Candle
+	37, // 24: proto.GetCandlesRequest.pair:type_name -> proto.OrderPair
+	0,  // 25: proto.GetCandlesRequest.interval:type_name -> proto.CandleInterval
+	37, // 26: proto.GetCandlesResponse.pair:type_name -> proto.OrderPair
+	0,  // 27: proto.GetCandlesResponse.interval:type_name -> proto.CandleInterval
+	14, // 28: proto.GetCandlesResponse.candles:type_name -> proto.Candle
+	38, // 29: proto.GetCandlesResponse.error:type_name -> proto.ErrorDto
+	37, // 30: proto.TickerEvent.pair:type_name -> proto.OrderPair
+	19, // 31: proto.ArbitrageOpportunityEvent.legs:type_name -> proto.ArbitrageLeg
+	37, // 32: proto.ArbitrageLeg.pair:type_name -> proto.OrderPair
+	39, // 33: proto.ArbitrageLeg.direction:type_name -> proto.OrderDirection
+	37, // 34: proto.LiquidityMetricsEvent.pair:type_name -> proto.OrderPair
+	21, // 35: proto.LiquidityMetricsEvent.depthBands:type_name -> proto.LiquidityDepthBand
+	23, // 36: proto.TradeEvent.trade:type_name -> proto.Trade
+	37, // 37: proto.Trade.pair:type_name -> proto.OrderPair
+	39, // 38: proto.Trade.aggressorSide:type_name -> proto.OrderDirection
+	37, // 39: proto.GetRecentTradesRequest.pair:type_name -> proto.OrderPair
+	37, // 40: proto.GetRecentTradesResponse.pair:type_name -> proto.OrderPair
+	23, // 41: proto.GetRecentTradesResponse.trades:type_name -> proto.Trade
+	38, // 42: proto.GetRecentTradesResponse.error:type_name -> proto.ErrorDto
+	37, // 43: proto.EstimateFillRequest.pair:type_name -> proto.OrderPair
+	39, // 44: proto.EstimateFillRequest.direction:type_name -> proto.OrderDirection
+	37, // 45: proto.EstimateFillResponse.pair:type_name -> proto.OrderPair
+	39, // 46: proto.EstimateFillResponse.direction:type_name -> proto.OrderDirection
+	38, // 47: proto.EstimateFillResponse.error:type_name -> proto.ErrorDto
+	28, // 48: proto.GetDeadLettersResponse.deadLetters:type_name -> proto.DeadLetter
+	38, // 49: proto.GetDeadLettersResponse.error:type_name -> proto.ErrorDto
+	38, // 50: proto.ReplayDeadLettersResponse.error:type_name -> proto.ErrorDto
+	35, // 51: proto.GetMarketDepthResponse.marketDepth:type_name -> proto.PairMatketDepth
+	38, // 52: proto.GetMarketDepthResponse.error:type_name -> proto.ErrorDto
+	37, // 53: proto.PairMatketDepth.pair:type_name -> proto.OrderPair
+	39, // 54: proto.PairMatketDepth.direction:type_name -> proto.OrderDirection
+	36, // 55: proto.PairMatketDepth.volumeByPrice:type_name -> proto.VolumeByPrice
+	56, // [56:56] is the sub-list for method output_type
+	56, // [56:56] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
+}
+
+func init() { file_proto_quote_proto_init() }
+func file_proto_quote_proto_init() {
+	if File_proto_quote_proto != nil {
+		return
+	}
+	file_proto_order_proto_init()
+	file_proto_error_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_quote_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotesEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossRateDeviation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AveragePrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAveragePricesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAveragePricesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketDepthEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketDepthDiffEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceLevelUpdate); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -413,7 +3361,307 @@ func file_proto_quote_proto_init() {
 				return nil
 			}
 		}
-		file_proto_quote_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_quote_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketDepthSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketDepthSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopOfBookEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CandleEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandlesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandlesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickerEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArbitrageOpportunityEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArbitrageLeg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityMetricsEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityDepthBand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentTradesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentTradesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFillResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketDepthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketDepthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_quote_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PairMatketDepth); i {
 			case 0:
 				return &v.state
@@ -425,7 +3673,7 @@ func file_proto_quote_proto_init() {
 				return nil
 			}
 		}
-		file_proto_quote_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_quote_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VolumeByPrice); i {
 			case 0:
 				return &v.state
@@ -443,13 +3691,14 @@ func file_proto_quote_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_quote_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_quote_proto_goTypes,
 		DependencyIndexes: file_proto_quote_proto_depIdxs,
+		EnumInfos:         file_proto_quote_proto_enumTypes,
 		MessageInfos:      file_proto_quote_proto_msgTypes,
 	}.Build()
 	File_proto_quote_proto = out.File
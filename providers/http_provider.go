@@ -0,0 +1,213 @@
+package providers
+
+import (
+	"QuoteService/proto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	googleProto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	httpReadHeaderTimeout = 5 * time.Second
+	httpReadTimeout       = 30 * time.Second
+	httpWriteTimeout      = 30 * time.Second
+	httpIdleTimeout       = 2 * time.Minute
+
+	quoteServiceHttpListeningMsg = "QuoteService serves http on: %s"
+	quoteServiceHttpHandledMsg   = "QuoteService handled http request: %s %s"
+	httpReadRequestErrMsg        = "Error while read http request: %s"
+	httpUnmarshalRequestErrMsg   = "Error while unmarshal http request to %s: %s"
+	httpWriteResponseErrMsg      = "Error while write http response: %s"
+	invalidQueryValueErrMsg      = "invalid value: %s of query parameter: %s"
+	httpMethodNotAllowedErrMsg   = "method %s is not allowed for: %s, use POST"
+)
+
+type HttpProvider struct {
+	Address string
+	mux     *http.ServeMux
+}
+
+func NewHttpProvider(address string) *HttpProvider {
+	return &HttpProvider{Address: address, mux: http.NewServeMux()}
+}
+
+// HandleRpc serves an RPC entrypoint over HTTP. The request message is read from the JSON body of a POST
+// or from the query parameters of a GET, named like the fields of the message and repeated for repeated fields, passed to
+// quoteEntrypointFunc in binary and its reply is written back as JSON. Replies with an ErrorDto are
+// answered with the status matching the error code.
+func (h *HttpProvider) HandleRpc(path string, request, response googleProto.Message, quoteEntrypointFunc func([]byte) []byte) {
+	h.mux.HandleFunc(path, newRpcHandler(request, response, quoteEntrypointFunc))
+}
+
+// HandlePostRpc serves an RPC entrypoint that changes state like HandleRpc, but only for POST requests,
+// so links, crawlers and prefetches cannot trigger it. Other methods are answered with 405 Method Not Allowed.
+func (h *HttpProvider) HandlePostRpc(path string, request, response googleProto.Message, quoteEntrypointFunc func([]byte) []byte) {
+	rpcHandler := newRpcHandler(request, response, quoteEntrypointFunc)
+	h.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			logger.Warnf(httpMethodNotAllowedErrMsg, r.Method, r.URL.Path)
+			w.Header().Set("Allow", http.MethodPost)
+			writeHttpResponse(w, http.StatusMethodNotAllowed, &proto.ErrorDto{
+				Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: fmt.Sprintf(httpMethodNotAllowedErrMsg, r.Method, r.URL.Path),
+			})
+			return
+		}
+
+		rpcHandler(w, r)
+	})
+}
+
+func newRpcHandler(request, response googleProto.Message, quoteEntrypointFunc func([]byte) []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger.Infof(quoteServiceHttpHandledMsg, r.Method, r.URL.String())
+
+		byteRequest, err := readHttpRequest(r, request)
+		if err != nil {
+			writeHttpResponse(w, http.StatusBadRequest, &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INVALID_REQUEST, Message: err.Error()})
+			return
+		}
+
+		httpResponse := response.ProtoReflect().New().Interface()
+		if err := googleProto.Unmarshal(quoteEntrypointFunc(byteRequest), httpResponse); err != nil {
+			writeHttpResponse(w, http.StatusInternalServerError, &proto.ErrorDto{Code: proto.ErrorCode_ERROR_INTERNAL, Message: err.Error()})
+			return
+		}
+
+		writeHttpResponse(w, getHttpStatus(httpResponse), httpResponse)
+	}
+}
+
+// Handle serves a plain http.Handler, such as the metrics endpoint, next to the RPC entrypoints.
+func (h *HttpProvider) Handle(path string, handler http.Handler) {
+	h.mux.Handle(path, handler)
+}
+
+// ListenAndServe serves the handlers with timeouts, so slow or idle clients cannot hold connections open forever.
+func (h *HttpProvider) ListenAndServe() error {
+	server := &http.Server{
+		Addr:              h.Address,
+		Handler:           h.mux,
+		ReadHeaderTimeout: httpReadHeaderTimeout,
+		ReadTimeout:       httpReadTimeout,
+		WriteTimeout:      httpWriteTimeout,
+		IdleTimeout:       httpIdleTimeout,
+	}
+
+	logger.Infof(quoteServiceHttpListeningMsg, h.Address)
+	return server.ListenAndServe()
+}
+
+func readHttpRequest(r *http.Request, request googleProto.Message) ([]byte, error) {
+	var jsonRequest []byte
+	var err error
+	switch r.Method {
+	case http.MethodGet:
+		if jsonRequest, err = getQueryJson(r.URL.Query(), request.ProtoReflect().Descriptor()); err != nil {
+			return nil, err
+		}
+	default:
+		if jsonRequest, err = io.ReadAll(r.Body); err != nil {
+			logger.Errorf(httpReadRequestErrMsg, err.Error())
+			return nil, err
+		}
+	}
+
+	httpRequest := request.ProtoReflect().New().Interface()
+	if len(jsonRequest) != 0 {
+		if err := protojson.Unmarshal(jsonRequest, httpRequest); err != nil {
+			logger.Errorf(httpUnmarshalRequestErrMsg, httpRequest.ProtoReflect().Descriptor().FullName(), err.Error())
+			return nil, err
+		}
+	}
+
+	return googleProto.Marshal(httpRequest)
+}
+
+// getQueryJson converts query parameters to the JSON form of the message described by descriptor,
+// typing every value by the kind of its field, so protojson reads them like a JSON body.
+func getQueryJson(query url.Values, descriptor protoreflect.MessageDescriptor) ([]byte, error) {
+	queryFields := map[string]interface{}{}
+	for name, values := range query {
+		field := descriptor.Fields().ByJSONName(name)
+		if field == nil {
+			field = descriptor.Fields().ByTextName(name)
+		}
+		// Parameters that are no field are kept as they are, protojson rejects them as unknown fields.
+		if field == nil {
+			queryFields[name] = values[0]
+			continue
+		}
+
+		jsonValues := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			jsonValue, err := getQueryValue(field, value)
+			if err != nil {
+				return nil, fmt.Errorf(invalidQueryValueErrMsg, value, name)
+			}
+			jsonValues = append(jsonValues, jsonValue)
+		}
+
+		if field.IsList() {
+			queryFields[name] = jsonValues
+		} else {
+			queryFields[name] = jsonValues[0]
+		}
+	}
+
+	return json.Marshal(queryFields)
+}
+
+// getQueryValue returns a query value as the JSON value protojson expects for field: bools as JSON booleans,
+// enums given by number as JSON numbers and everything else as a string, which protojson parses for numbers too.
+func getQueryValue(field protoreflect.FieldDescriptor, value string) (interface{}, error) {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.ParseBool(value)
+	case protoreflect.EnumKind:
+		if number, err := strconv.ParseInt(value, 10, 32); err == nil {
+			return number, nil
+		}
+	}
+	return value, nil
+}
+
+// getHttpStatus maps the ErrorDto in the error field of the response, if any, to an HTTP status.
+func getHttpStatus(response googleProto.Message) int {
+	errorField := response.ProtoReflect().Descriptor().Fields().ByName("error")
+	if errorField == nil || !response.ProtoReflect().Has(errorField) {
+		return http.StatusOK
+	}
+
+	errorDto, ok := response.ProtoReflect().Get(errorField).Message().Interface().(*proto.ErrorDto)
+	if !ok || errorDto.Code == proto.ErrorCode_ERROR_NONE {
+		return http.StatusOK
+	}
+	if errorDto.Code == proto.ErrorCode_ERROR_INVALID_REQUEST {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func writeHttpResponse(w http.ResponseWriter, status int, response googleProto.Message) {
+	jsonResponse, err := protojson.Marshal(response)
+	if err != nil {
+		logger.Errorf(httpWriteResponseErrMsg, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(jsonResponse); err != nil {
+		logger.Errorf(httpWriteResponseErrMsg, err.Error())
+	}
+}
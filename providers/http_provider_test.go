@@ -0,0 +1,121 @@
+package providers
+
+import (
+	"QuoteService/proto"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	googleProto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestReadHttpRequestFromQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		request googleProto.Message
+		want    googleProto.Message
+		wantErr bool
+	}{
+		{
+			name:    "enums by name and numbers",
+			query:   "pair=USD_EUR&interval=HOUR_1&fromTime=1700000000000&toTime=1700003600000&limit=10",
+			request: &proto.GetCandlesRequest{},
+			want: &proto.GetCandlesRequest{
+				Pair: proto.OrderPair_USD_EUR, Interval: proto.CandleInterval_HOUR_1, FromTime: 1700000000000, ToTime: 1700003600000, Limit: 10,
+			},
+		},
+		{
+			name:    "enums by number and doubles",
+			query:   "pair=1&direction=1&volume=2.5",
+			request: &proto.EstimateFillRequest{},
+			want:    &proto.EstimateFillRequest{Pair: proto.OrderPair(1), Direction: proto.OrderDirection(1), Volume: 2.5},
+		},
+		{
+			name:    "bools",
+			query:   "deprecated=true&lazy=1",
+			request: &descriptorpb.FieldOptions{},
+			want:    &descriptorpb.FieldOptions{Deprecated: googleProto.Bool(true), Lazy: googleProto.Bool(true)},
+		},
+		{
+			name:    "repeated fields by json and proto name",
+			query:   "dependency=a.proto&dependency=b.proto&public_dependency=0&public_dependency=1",
+			request: &descriptorpb.FileDescriptorProto{},
+			want:    &descriptorpb.FileDescriptorProto{Dependency: []string{"a.proto", "b.proto"}, PublicDependency: []int32{0, 1}},
+		},
+		{
+			name:    "invalid bool",
+			query:   "deprecated=yes",
+			request: &descriptorpb.FieldOptions{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid number",
+			query:   "limit=ten",
+			request: &proto.GetRecentTradesRequest{},
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			query:   "pairs=USD_EUR",
+			request: &proto.GetRecentTradesRequest{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byteRequest, err := readHttpRequest(httptest.NewRequest("GET", "/?"+tt.query, nil), tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readHttpRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got := tt.request.ProtoReflect().New().Interface()
+			if err := googleProto.Unmarshal(byteRequest, got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !googleProto.Equal(got, tt.want) {
+				t.Errorf("readHttpRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandlePostRpcMethods(t *testing.T) {
+	tests := []struct {
+		method     string
+		wantStatus int
+		wantCalled bool
+	}{
+		{method: http.MethodPost, wantStatus: http.StatusOK, wantCalled: true},
+		{method: http.MethodGet, wantStatus: http.StatusMethodNotAllowed},
+		{method: http.MethodPut, wantStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			called := false
+			h := NewHttpProvider("")
+			h.HandlePostRpc("/replay", &proto.ReplayDeadLettersRequest{}, &proto.ReplayDeadLettersResponse{}, func([]byte) []byte {
+				called = true
+				byteResponse, _ := googleProto.Marshal(&proto.ReplayDeadLettersResponse{})
+				return byteResponse
+			})
+
+			w := httptest.NewRecorder()
+			h.mux.ServeHTTP(w, httptest.NewRequest(tt.method, "/replay", strings.NewReader("{}")))
+
+			if w.Code != tt.wantStatus || called != tt.wantCalled {
+				t.Errorf("%s = status %d, called %v, want %d, %v", tt.method, w.Code, called, tt.wantStatus, tt.wantCalled)
+			}
+			if tt.wantStatus == http.StatusMethodNotAllowed && w.Header().Get("Allow") != http.MethodPost {
+				t.Errorf("Allow = %q, want %q", w.Header().Get("Allow"), http.MethodPost)
+			}
+		})
+	}
+}
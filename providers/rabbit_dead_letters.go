@@ -0,0 +1,268 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"github.com/streadway/amqp"
+)
+
+var (
+	deadLetterQueueSuffix = ".DLQ"
+	retryQueueSuffix      = ".Retry"
+
+	maxDeliveryRetries = 5
+	retryDelay         = time.Second
+
+	defaultDeadLettersLimit = 100
+	maxDeadLettersLimit     = 1000
+
+	retryCountHeader         = "x-retry-count"
+	deadLetterReasonHeader   = "x-dead-letter-reason"
+	originalExchangeHeader   = "x-original-exchange"
+	originalRoutingKeyHeader = "x-original-routing-key"
+
+	// ErrPoisonMessage is wrapped by listener errors no retry can fix, such as a message that cannot be
+	// unmarshalled, so the message goes straight to the dead letter queue.
+	ErrPoisonMessage = errors.New("poison message")
+	// ErrUnknownDeadLetterQueue is returned for a queue no listener with a dead letter queue consumes.
+	ErrUnknownDeadLetterQueue = errors.New("unknown dead letter queue")
+
+	retryingMessageMsg     = "QuoteService retrying message from queue: %s, attempt %d of %d: %s"
+	deadLetteredMessageMsg = "QuoteService moved message from queue: %s to %s: %s"
+	replayedDeadLettersMsg = "QuoteService replayed %d messages from %s to %s"
+	settleMessageErrMsg    = "Error while settle message from queue: %s: %s"
+)
+
+// DeadLetter is a message moved to the dead letter queue of a listener queue.
+type DeadLetter struct {
+	Exchange   string
+	RoutingKey string
+	Reason     string
+	Retries    int
+	Body       []byte
+}
+
+func PoisonMessageError(format string, a ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrPoisonMessage, fmt.Sprintf(format, a...))
+}
+
+func getDeadLetterQueueName(queueName string) string {
+	return queueName + deadLetterQueueSuffix
+}
+
+func getRetryQueueName(queueName string) string {
+	return queueName + retryQueueSuffix
+}
+
+// getRetryQueueTopology returns the topology of the retry queue of queueName. Messages wait there for retryDelay,
+// unless it is configured with another messageTtl, and then go back to queueName through the default exchange.
+func (r *RabbitProvider) getRetryQueueTopology(queueName string, topology QueueTopology) QueueTopology {
+	retryQueueTopology, exists := r.Topology.Queues[getRetryQueueName(queueName)]
+	if !exists {
+		retryQueueTopology = QueueTopology{Durable: topology.Durable}
+	}
+	if retryQueueTopology.MessageTtl == 0 {
+		retryQueueTopology.MessageTtl = retryDelay.Milliseconds()
+	}
+
+	defaultExchange := ""
+	retryQueueTopology.DeadLetterExchange = &defaultExchange
+	retryQueueTopology.DeadLetterRoutingKey = queueName
+	return retryQueueTopology
+}
+
+func (r *RabbitProvider) registerDeadLetterQueue(queueName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.deadLetterQueues == nil {
+		r.deadLetterQueues = map[string]bool{}
+	}
+	r.deadLetterQueues[queueName] = true
+}
+
+func (r *RabbitProvider) hasDeadLetterQueue(queueName string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.deadLetterQueues[queueName]
+}
+
+// settleDelivery acks msg once the listener handled it. A poison message is moved to the dead letter queue,
+// any other failure is retried up to maxDeliveryRetries times before the message is moved there too.
+func (r *RabbitProvider) settleDelivery(queueName string, msg amqp.Delivery, handleErr error) {
+	var err error
+	switch {
+	case handleErr == nil:
+		err = msg.Ack(false)
+	case errors.Is(handleErr, ErrPoisonMessage):
+		err = r.deadLetter(queueName, msg, handleErr)
+	default:
+		err = r.retry(queueName, msg, handleErr)
+	}
+
+	if err != nil {
+		logger.Errorf(settleMessageErrMsg, queueName, err.Error())
+	}
+}
+
+// retry publishes msg to the retry queue of queueName with its retry count increased, rabbit moves it
+// back to the end of queueName once it waited there, so the consumer goes on with the next message meanwhile.
+// The message is requeued as it is when it cannot be published.
+func (r *RabbitProvider) retry(queueName string, msg amqp.Delivery, handleErr error) error {
+	retries := getRetryCount(msg.Headers) + 1
+	if retries > maxDeliveryRetries {
+		return r.deadLetter(queueName, msg, handleErr)
+	}
+
+	logger.Warnf(retryingMessageMsg, queueName, retries, maxDeliveryRetries, handleErr.Error())
+
+	headers := getOriginalHeaders(msg)
+	headers[retryCountHeader] = int32(retries)
+	if err := r.republish(getRetryQueueName(queueName), msg, headers); err != nil {
+		return errors.Join(err, msg.Nack(false, true))
+	}
+	return msg.Ack(false)
+}
+
+// deadLetter publishes msg to the dead letter queue of queueName with the reason it failed.
+func (r *RabbitProvider) deadLetter(queueName string, msg amqp.Delivery, handleErr error) error {
+	deadLetterQueueName := getDeadLetterQueueName(queueName)
+
+	headers := getOriginalHeaders(msg)
+	headers[deadLetterReasonHeader] = handleErr.Error()
+	if err := r.republish(deadLetterQueueName, msg, headers); err != nil {
+		return errors.Join(err, msg.Nack(false, true))
+	}
+
+	logger.Errorf(deadLetteredMessageMsg, queueName, deadLetterQueueName, handleErr.Error())
+	return msg.Ack(false)
+}
+
+// GetDeadLetters returns up to limit messages from the dead letter queue of queueName, oldest first, leaving them in the queue.
+func (r *RabbitProvider) GetDeadLetters(queueName string, limit int) ([]*DeadLetter, error) {
+	if !r.hasDeadLetterQueue(queueName) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownDeadLetterQueue, queueName)
+	}
+
+	ch, err := r.openChannel()
+	if err != nil {
+		return nil, err
+	}
+	// Messages got without ack go back to the queue in their order when the channel is closed.
+	defer ch.Close()
+
+	var deadLetters []*DeadLetter
+	for len(deadLetters) < getDeadLettersLimit(limit) {
+		msg, ok, err := ch.Get(getDeadLetterQueueName(queueName), false)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+
+		deadLetter := &DeadLetter{Retries: getRetryCount(msg.Headers), Body: msg.Body}
+		deadLetter.Exchange, _ = msg.Headers[originalExchangeHeader].(string)
+		deadLetter.RoutingKey, _ = msg.Headers[originalRoutingKeyHeader].(string)
+		deadLetter.Reason, _ = msg.Headers[deadLetterReasonHeader].(string)
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return deadLetters, nil
+}
+
+// ReplayDeadLetters moves up to limit messages from the dead letter queue of queueName back to queueName
+// with their retries reset and returns how many were moved.
+func (r *RabbitProvider) ReplayDeadLetters(queueName string, limit int) (int, error) {
+	if !r.hasDeadLetterQueue(queueName) {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownDeadLetterQueue, queueName)
+	}
+
+	ch, err := r.openChannel()
+	if err != nil {
+		return 0, err
+	}
+	defer ch.Close()
+
+	deadLetterQueueName := getDeadLetterQueueName(queueName)
+	replayed := 0
+	for replayed < getDeadLettersLimit(limit) {
+		msg, ok, err := ch.Get(deadLetterQueueName, false)
+		if err != nil {
+			return replayed, err
+		}
+		if !ok {
+			break
+		}
+
+		headers := getOriginalHeaders(msg)
+		delete(headers, retryCountHeader)
+		delete(headers, deadLetterReasonHeader)
+		if err := r.republish(queueName, msg, headers); err != nil {
+			return replayed, err
+		}
+		if err := msg.Ack(false); err != nil {
+			return replayed, err
+		}
+		replayed++
+	}
+
+	logger.Infof(replayedDeadLettersMsg, replayed, deadLetterQueueName, queueName)
+	return replayed, nil
+}
+
+func getDeadLettersLimit(limit int) int {
+	if limit <= 0 {
+		return defaultDeadLettersLimit
+	}
+	if limit > maxDeadLettersLimit {
+		return maxDeadLettersLimit
+	}
+	return limit
+}
+
+// getOriginalHeaders copies the headers of msg, remembering the exchange and routing key it was first published with.
+func getOriginalHeaders(msg amqp.Delivery) amqp.Table {
+	headers := amqp.Table{}
+	for name, value := range msg.Headers {
+		headers[name] = value
+	}
+	if _, exists := headers[originalExchangeHeader]; !exists {
+		headers[originalExchangeHeader] = msg.Exchange
+		headers[originalRoutingKeyHeader] = msg.RoutingKey
+	}
+
+	return headers
+}
+
+func getRetryCount(headers amqp.Table) int {
+	switch retries := headers[retryCountHeader].(type) {
+	case int32:
+		return int(retries)
+	case int64:
+		return int(retries)
+	default:
+		return 0
+	}
+}
+
+// republish sends msg straight to queueName through the default exchange, persisted in case queueName is durable,
+// and waits until rabbit confirms it, so msg is acked only once its copy cannot be lost.
+func (r *RabbitProvider) republish(queueName string, msg amqp.Delivery, headers amqp.Table) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sendMessageTimeout)
+	defer cancel()
+
+	return r.publishConfirmed(ctx, "", queueName, amqp.Publishing{
+		Headers:       headers,
+		DeliveryMode:  amqp.Persistent,
+		ContentType:   msg.ContentType,
+		CorrelationId: msg.CorrelationId,
+		ReplyTo:       msg.ReplyTo,
+		Body:          msg.Body,
+	})
+}
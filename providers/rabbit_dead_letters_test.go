@@ -0,0 +1,59 @@
+package providers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/streadway/amqp"
+)
+
+func TestGetRetryQueueTopologyArguments(t *testing.T) {
+	queueName := "q.Listener"
+	configuredExchange := "ex.Other"
+
+	tests := []struct {
+		name        string
+		queues      map[string]QueueTopology
+		topology    QueueTopology
+		want        amqp.Table
+		wantDurable bool
+	}{
+		{
+			name:     "waits retryDelay and goes back to the queue",
+			topology: QueueTopology{Durable: true, MaxLength: 10},
+			want: amqp.Table{
+				messageTtlArgument:           retryDelay.Milliseconds(),
+				deadLetterExchangeArgument:   "",
+				deadLetterRoutingKeyArgument: queueName,
+			},
+			wantDurable: true,
+		},
+		{
+			name: "configured retry queue keeps its ttl but not its dead letter exchange",
+			queues: map[string]QueueTopology{
+				getRetryQueueName(queueName): {MessageTtl: 5000, MaxLength: 100, DeadLetterExchange: &configuredExchange},
+			},
+			topology: QueueTopology{Durable: true},
+			want: amqp.Table{
+				messageTtlArgument:           int64(5000),
+				maxLengthArgument:            int64(100),
+				deadLetterExchangeArgument:   "",
+				deadLetterRoutingKeyArgument: queueName,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RabbitProvider{Topology: &Topology{Queues: tt.queues}}
+
+			retryQueueTopology := r.getRetryQueueTopology(queueName, tt.topology)
+			if got := retryQueueTopology.getArguments(""); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getArguments() = %v, want %v", got, tt.want)
+			}
+			if retryQueueTopology.Durable != tt.wantDurable {
+				t.Errorf("Durable = %v, want %v", retryQueueTopology.Durable, tt.wantDurable)
+			}
+		})
+	}
+}
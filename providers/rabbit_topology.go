@@ -0,0 +1,174 @@
+package providers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	logger "github.com/sirupsen/logrus"
+	"github.com/streadway/amqp"
+)
+
+var (
+	messageTtlArgument           = "x-message-ttl"
+	maxLengthArgument            = "x-max-length"
+	deadLetterExchangeArgument   = "x-dead-letter-exchange"
+	deadLetterRoutingKeyArgument = "x-dead-letter-routing-key"
+
+	invalidQueueTopologyErrMsg = "queue %s has negative %s: %d"
+	mismatchedTopologyMsg      = "QuoteService found %s declared with other arguments, using it as it is until it is deleted: %s"
+)
+
+// Topology configures how exchanges and queues are declared. Exchanges and queues without their own
+// entry use the default one.
+type Topology struct {
+	DefaultExchange ExchangeTopology            `json:"defaultExchange"`
+	Exchanges       map[string]ExchangeTopology `json:"exchanges"`
+	DefaultQueue    QueueTopology               `json:"defaultQueue"`
+	Queues          map[string]QueueTopology    `json:"queues"`
+}
+
+type ExchangeTopology struct {
+	Durable    bool `json:"durable"`
+	AutoDelete bool `json:"autoDelete"`
+}
+
+type QueueTopology struct {
+	Durable    bool `json:"durable"`
+	AutoDelete bool `json:"autoDelete"`
+	// MessageTtl is how long a message waits in the queue in milliseconds, 0 for no limit.
+	MessageTtl int64 `json:"messageTtl,omitempty"`
+	// MaxLength is how many messages the queue keeps before dropping the oldest one, 0 for no limit.
+	MaxLength int64 `json:"maxLength,omitempty"`
+	// DeadLetterExchange and DeadLetterRoutingKey get the messages dropped for MessageTtl or MaxLength. Listener
+	// queues send them to their dead letter queue through the default exchange when DeadLetterExchange is not set.
+	DeadLetterExchange   *string `json:"deadLetterExchange,omitempty"`
+	DeadLetterRoutingKey string  `json:"deadLetterRoutingKey,omitempty"`
+	// Prefetch is how many unacked messages a consumer gets at a time, 0 for no limit.
+	Prefetch int `json:"prefetch,omitempty"`
+}
+
+// LoadTopology reads the topology config file.
+func LoadTopology(configPath string) (*Topology, error) {
+	configBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var topology Topology
+	if err := json.Unmarshal(configBytes, &topology); err != nil {
+		return nil, err
+	}
+
+	if err := topology.DefaultQueue.check("default"); err != nil {
+		return nil, err
+	}
+	for queueName, queueTopology := range topology.Queues {
+		if err := queueTopology.check(queueName); err != nil {
+			return nil, err
+		}
+	}
+
+	return &topology, nil
+}
+
+func (t *Topology) getExchangeTopology(exName string) ExchangeTopology {
+	if exchangeTopology, exists := t.Exchanges[exName]; exists {
+		return exchangeTopology
+	}
+	return t.DefaultExchange
+}
+
+func (t *Topology) getQueueTopology(queueName string) QueueTopology {
+	if queueTopology, exists := t.Queues[queueName]; exists {
+		return queueTopology
+	}
+	return t.DefaultQueue
+}
+
+func (q QueueTopology) check(queueName string) error {
+	switch {
+	case q.MessageTtl < 0:
+		return fmt.Errorf(invalidQueueTopologyErrMsg, queueName, "messageTtl", q.MessageTtl)
+	case q.MaxLength < 0:
+		return fmt.Errorf(invalidQueueTopologyErrMsg, queueName, "maxLength", q.MaxLength)
+	case q.Prefetch < 0:
+		return fmt.Errorf(invalidQueueTopologyErrMsg, queueName, "prefetch", q.Prefetch)
+	default:
+		return nil
+	}
+}
+
+// getArguments returns the queue arguments, deadLetterQueueName is the dead letter queue of a listener queue or empty.
+func (q QueueTopology) getArguments(deadLetterQueueName string) amqp.Table {
+	arguments := amqp.Table{}
+	if q.MessageTtl > 0 {
+		arguments[messageTtlArgument] = q.MessageTtl
+	}
+	if q.MaxLength > 0 {
+		arguments[maxLengthArgument] = q.MaxLength
+	}
+
+	switch {
+	case q.DeadLetterExchange != nil:
+		arguments[deadLetterExchangeArgument] = *q.DeadLetterExchange
+		if q.DeadLetterRoutingKey != "" {
+			arguments[deadLetterRoutingKeyArgument] = q.DeadLetterRoutingKey
+		}
+	case deadLetterQueueName != "":
+		arguments[deadLetterExchangeArgument] = ""
+		arguments[deadLetterRoutingKeyArgument] = deadLetterQueueName
+	}
+
+	return arguments
+}
+
+func declareExchange(openChannel func() (*amqp.Channel, error), exName string, topology ExchangeTopology) error {
+	return declare(openChannel, exName,
+		func(ch *amqp.Channel) error {
+			return ch.ExchangeDeclare(exName, "topic", topology.Durable, topology.AutoDelete, false, false, nil)
+		},
+		func(ch *amqp.Channel) error {
+			return ch.ExchangeDeclarePassive(exName, "topic", topology.Durable, topology.AutoDelete, false, false, nil)
+		},
+	)
+}
+
+func declareQueue(openChannel func() (*amqp.Channel, error), queueName string, topology QueueTopology, arguments amqp.Table) error {
+	return declare(openChannel, queueName,
+		func(ch *amqp.Channel) error {
+			_, err := ch.QueueDeclare(queueName, topology.Durable, topology.AutoDelete, false, false, arguments)
+			return err
+		},
+		func(ch *amqp.Channel) error {
+			_, err := ch.QueueDeclarePassive(queueName, topology.Durable, topology.AutoDelete, false, false, arguments)
+			return err
+		},
+	)
+}
+
+// declare runs declareFunc on a new channel. When the exchange or queue already exists with other arguments
+// the broker closes the channel with PRECONDITION_FAILED, and applying them would mean deleting it with its
+// messages, so its existence is only checked with declarePassiveFunc on another channel and it is used as it is.
+func declare(openChannel func() (*amqp.Channel, error), name string, declareFunc, declarePassiveFunc func(*amqp.Channel) error) error {
+	err := withChannel(openChannel, declareFunc)
+
+	var amqpErr *amqp.Error
+	if !errors.As(err, &amqpErr) || amqpErr.Code != amqp.PreconditionFailed {
+		return err
+	}
+
+	logger.Warnf(mismatchedTopologyMsg, name, amqpErr.Reason)
+	return withChannel(openChannel, declarePassiveFunc)
+}
+
+func withChannel(openChannel func() (*amqp.Channel, error), channelFunc func(*amqp.Channel) error) error {
+	ch, err := openChannel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	return channelFunc(ch)
+}
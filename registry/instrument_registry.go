@@ -0,0 +1,401 @@
+package registry
+
+import (
+	"QuoteService/models"
+	"QuoteService/proto"
+	"QuoteService/utils"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	logger "github.com/sirupsen/logrus"
+)
+
+var (
+	instrumentsKey       = "instruments"
+	activeInstrumentsKey = "instruments:active"
+	instrumentStepsKey   = "instruments:steps"
+
+	missingInstrumentIdErrMsg        = "instrument %s is not an OrderPair and has no id"
+	mismatchedInstrumentIdErrMsg     = "instrument %s has id %d, but OrderPair %s is %d"
+	duplicateInstrumentErrMsg        = "instrument %s is configured more than once"
+	duplicateInstrumentIdErrMsg      = "instruments %s and %s have the same id %d"
+	missingCurrencyErrMsg            = "instrument %s has no base or quote currency"
+	invalidInstrumentStepErrMsg      = "instrument %s has invalid %s: %s"
+	invalidInstrumentPrecisionErrMsg = "instrument %s has %s precision %d lower than the scale of its %s"
+
+	invalidStoredInstrumentErrMsg = "Skipping invalid instrument %s stored in redis: %s"
+	changedInstrumentStepsErrMsg  = "Instrument %s has priceTick %s and volumeStep %s in redis, but its data is stored in priceTick %s and volumeStep %s, keeping them"
+	mismatchedConfigInstrumentMsg = "QuoteService instrument %s has priceTick %s and volumeStep %s in config, but %s and %s in redis, using redis"
+	refreshInstrumentsErrMsg      = "Error while refresh instruments: %s"
+	seededInstrumentMsg           = "QuoteService seeded instrument: %s"
+	enabledInstrumentMsg          = "QuoteService enabled instrument: %s"
+	disabledInstrumentMsg         = "QuoteService disabled instrument: %s"
+)
+
+type instrumentsConfig struct {
+	Instruments []instrumentConfig `json:"instruments"`
+}
+
+// instrumentSteps are the price tick and volume step the data of a pair is stored in.
+type instrumentSteps struct {
+	PriceTick  string `json:"priceTick"`
+	VolumeStep string `json:"volumeStep"`
+}
+
+type instrumentConfig struct {
+	Pair string `json:"pair"`
+	// Id is the OrderPair value orders of the pair are sent with. It may be omitted for pairs
+	// declared in order.proto and is required for pairs added without rebuilding it.
+	Id              *int32 `json:"id,omitempty"`
+	BaseCurrency    string `json:"baseCurrency"`
+	QuoteCurrency   string `json:"quoteCurrency"`
+	PriceTick       string `json:"priceTick"`
+	VolumeStep      string `json:"volumeStep"`
+	PricePrecision  int    `json:"pricePrecision"`
+	VolumePrecision int    `json:"volumePrecision"`
+	// Enabled is only used when the instrument is seeded into redis for the first time,
+	// afterwards the instruments:active set decides whether the pair is active.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// InstrumentRegistry describes every traded pair: its currencies, the price tick and volume step
+// prices and volumes are rounded to, and the precision they are displayed with.
+//
+// The instruments hash and the instruments:active set in redis are the source of truth, so pairs
+// can be added or disabled at runtime: the config file only seeds instruments redis does not
+// know yet, and the registry reloads redis on schedule.
+//
+// Books, candles, tickers and trades are stored in whole price ticks and volume steps, so the tick
+// and step of a pair cannot change once it is loaded: the instruments:steps hash keeps the ones
+// its data is stored in and changes to them are logged and ignored.
+type InstrumentRegistry struct {
+	redisClient *redis.Client
+
+	mu          sync.RWMutex
+	instruments map[string]*models.InstrumentModel
+	byId        map[proto.OrderPair]*models.InstrumentModel
+}
+
+// NewInstrumentRegistry seeds redis with the instruments of the config file and loads the registry from redis.
+func NewInstrumentRegistry(redisClient *redis.Client, configPath string) (*InstrumentRegistry, error) {
+	instrumentRegistry := &InstrumentRegistry{redisClient: redisClient}
+	if err := instrumentRegistry.seed(configPath); err != nil {
+		return nil, err
+	}
+	if err := instrumentRegistry.Refresh(); err != nil {
+		return nil, err
+	}
+
+	return instrumentRegistry, nil
+}
+
+func (r *InstrumentRegistry) seed(configPath string) error {
+	configBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+
+	var config instrumentsConfig
+	if err := json.Unmarshal(configBytes, &config); err != nil {
+		return err
+	}
+
+	instruments := map[string]*models.InstrumentModel{}
+	for _, instrumentConfig := range config.Instruments {
+		instrument, err := newInstrument(instrumentConfig)
+		if err != nil {
+			return err
+		}
+		if _, exists := instruments[instrument.Pair]; exists {
+			return fmt.Errorf(duplicateInstrumentErrMsg, instrument.Pair)
+		}
+		instruments[instrument.Pair] = instrument
+
+		instrumentJson, err := json.Marshal(instrumentConfig)
+		if err != nil {
+			return err
+		}
+
+		seeded, err := r.redisClient.HSetNX(context.Background(), instrumentsKey, instrument.Pair, instrumentJson).Result()
+		if err != nil {
+			return err
+		}
+		if !seeded {
+			if err := r.checkStoredInstrument(instrument); err != nil {
+				return err
+			}
+			continue
+		}
+
+		logger.Infof(seededInstrumentMsg, instrument.Pair)
+		if instrumentConfig.Enabled == nil || *instrumentConfig.Enabled {
+			if err := r.redisClient.SAdd(context.Background(), activeInstrumentsKey, instrument.Pair).Err(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return checkUniqueIds(instruments)
+}
+
+// checkStoredInstrument warns when the tick or step of a configured instrument differs from the one in redis,
+// which the config file does not change.
+func (r *InstrumentRegistry) checkStoredInstrument(instrument *models.InstrumentModel) error {
+	instrumentJson, err := r.redisClient.HGet(context.Background(), instrumentsKey, instrument.Pair).Result()
+	if err != nil {
+		return err
+	}
+
+	var storedConfig instrumentConfig
+	if err := json.Unmarshal([]byte(instrumentJson), &storedConfig); err != nil {
+		logger.Errorf(invalidStoredInstrumentErrMsg, instrument.Pair, err.Error())
+		return nil
+	}
+	if storedConfig.PriceTick != instrument.PriceTick.String() || storedConfig.VolumeStep != instrument.VolumeStep.String() {
+		logger.Warnf(mismatchedConfigInstrumentMsg, instrument.Pair, instrument.PriceTick, instrument.VolumeStep, storedConfig.PriceTick, storedConfig.VolumeStep)
+	}
+	return nil
+}
+
+// Refresh reloads the instruments and the set of active pairs from redis.
+func (r *InstrumentRegistry) Refresh() error {
+	storedInstruments, err := r.redisClient.HGetAll(context.Background(), instrumentsKey).Result()
+	if err != nil {
+		return err
+	}
+	activePairs, err := r.redisClient.SMembers(context.Background(), activeInstrumentsKey).Result()
+	if err != nil {
+		return err
+	}
+	instrumentSteps, err := r.redisClient.HGetAll(context.Background(), instrumentStepsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	active := map[string]bool{}
+	for _, pair := range activePairs {
+		active[pair] = true
+	}
+
+	instruments := map[string]*models.InstrumentModel{}
+	byId := map[proto.OrderPair]*models.InstrumentModel{}
+	for pair, instrumentJson := range storedInstruments {
+		var config instrumentConfig
+		if err := json.Unmarshal([]byte(instrumentJson), &config); err != nil {
+			logger.Errorf(invalidStoredInstrumentErrMsg, pair, err.Error())
+			continue
+		}
+		config.Pair = pair
+
+		instrument, err := newInstrument(config)
+		if err != nil {
+			logger.Errorf(invalidStoredInstrumentErrMsg, pair, err.Error())
+			continue
+		}
+		if other, exists := byId[instrument.Id]; exists {
+			logger.Errorf(invalidStoredInstrumentErrMsg, pair, fmt.Sprintf(duplicateInstrumentIdErrMsg, other.Pair, pair, instrument.Id))
+			continue
+		}
+		if err := r.keepInstrumentSteps(instrument, instrumentSteps[pair]); err != nil {
+			return err
+		}
+
+		instrument.Enabled = active[pair]
+		instruments[pair] = instrument
+		byId[instrument.Id] = instrument
+	}
+
+	r.mu.Lock()
+	previousInstruments := r.instruments
+	r.instruments, r.byId = instruments, byId
+	r.mu.Unlock()
+
+	for pair, instrument := range instruments {
+		previousInstrument, existed := previousInstruments[pair]
+		wasEnabled := existed && previousInstrument.Enabled
+		if instrument.Enabled && !wasEnabled {
+			logger.Infof(enabledInstrumentMsg, pair)
+		} else if !instrument.Enabled && wasEnabled {
+			logger.Infof(disabledInstrumentMsg, pair)
+		}
+	}
+	for pair, previousInstrument := range previousInstruments {
+		if _, exists := instruments[pair]; !exists && previousInstrument.Enabled {
+			logger.Infof(disabledInstrumentMsg, pair)
+		}
+	}
+
+	return nil
+}
+
+// keepInstrumentSteps sets the tick and step of instrument to the ones its data is stored in, given as stepsJson
+// from the instruments:steps hash. A pair without them yet stores its current ones there.
+func (r *InstrumentRegistry) keepInstrumentSteps(instrument *models.InstrumentModel, stepsJson string) error {
+	steps := instrumentSteps{PriceTick: instrument.PriceTick.String(), VolumeStep: instrument.VolumeStep.String()}
+	if stepsJson == "" {
+		newStepsJson, err := json.Marshal(steps)
+		if err != nil {
+			return err
+		}
+		stored, err := r.redisClient.HSetNX(context.Background(), instrumentStepsKey, instrument.Pair, newStepsJson).Result()
+		if err != nil || stored {
+			return err
+		}
+		// Another replica stored them in between.
+		if stepsJson, err = r.redisClient.HGet(context.Background(), instrumentStepsKey, instrument.Pair).Result(); err != nil {
+			return err
+		}
+	}
+
+	var storedSteps instrumentSteps
+	if err := json.Unmarshal([]byte(stepsJson), &storedSteps); err != nil {
+		return err
+	}
+	if storedSteps == steps {
+		return nil
+	}
+
+	priceTick, err := utils.ParseStep(storedSteps.PriceTick)
+	if err != nil {
+		return err
+	}
+	volumeStep, err := utils.ParseStep(storedSteps.VolumeStep)
+	if err != nil {
+		return err
+	}
+
+	logger.Errorf(changedInstrumentStepsErrMsg, instrument.Pair, steps.PriceTick, steps.VolumeStep, storedSteps.PriceTick, storedSteps.VolumeStep)
+	instrument.PriceTick, instrument.VolumeStep = priceTick, volumeStep
+	return nil
+}
+
+func (r *InstrumentRegistry) RefreshBySchedule(refreshInstrumentsScheduleTime time.Duration) {
+	for {
+		time.Sleep(refreshInstrumentsScheduleTime)
+
+		if err := r.Refresh(); err != nil {
+			logger.Errorf(refreshInstrumentsErrMsg, err.Error())
+		}
+	}
+}
+
+func newInstrument(config instrumentConfig) (*models.InstrumentModel, error) {
+	enumValue, isEnumPair := proto.OrderPair_value[config.Pair]
+	var id int32
+	switch {
+	case config.Id != nil && isEnumPair && *config.Id != enumValue:
+		return nil, fmt.Errorf(mismatchedInstrumentIdErrMsg, config.Pair, *config.Id, config.Pair, enumValue)
+	case config.Id != nil:
+		id = *config.Id
+	case isEnumPair:
+		id = enumValue
+	default:
+		return nil, fmt.Errorf(missingInstrumentIdErrMsg, config.Pair)
+	}
+
+	if config.BaseCurrency == "" || config.QuoteCurrency == "" {
+		return nil, fmt.Errorf(missingCurrencyErrMsg, config.Pair)
+	}
+
+	priceTick, err := utils.ParseStep(config.PriceTick)
+	if err != nil {
+		return nil, fmt.Errorf(invalidInstrumentStepErrMsg, config.Pair, "priceTick", config.PriceTick)
+	}
+	volumeStep, err := utils.ParseStep(config.VolumeStep)
+	if err != nil {
+		return nil, fmt.Errorf(invalidInstrumentStepErrMsg, config.Pair, "volumeStep", config.VolumeStep)
+	}
+
+	if config.PricePrecision < priceTick.Scale {
+		return nil, fmt.Errorf(invalidInstrumentPrecisionErrMsg, config.Pair, "price", config.PricePrecision, "priceTick")
+	}
+	if config.VolumePrecision < volumeStep.Scale {
+		return nil, fmt.Errorf(invalidInstrumentPrecisionErrMsg, config.Pair, "volume", config.VolumePrecision, "volumeStep")
+	}
+
+	return &models.InstrumentModel{
+		Id:              proto.OrderPair(id),
+		Pair:            config.Pair,
+		BaseCurrency:    config.BaseCurrency,
+		QuoteCurrency:   config.QuoteCurrency,
+		PriceTick:       priceTick,
+		VolumeStep:      volumeStep,
+		PricePrecision:  config.PricePrecision,
+		VolumePrecision: config.VolumePrecision,
+	}, nil
+}
+
+func checkUniqueIds(instruments map[string]*models.InstrumentModel) error {
+	byId := map[proto.OrderPair]string{}
+	for pair, instrument := range instruments {
+		if other, exists := byId[instrument.Id]; exists {
+			return fmt.Errorf(duplicateInstrumentIdErrMsg, other, pair, instrument.Id)
+		}
+		byId[instrument.Id] = pair
+	}
+
+	return nil
+}
+
+// Get returns the instrument of the pair, whether it is active or not.
+func (r *InstrumentRegistry) Get(pair string) (*models.InstrumentModel, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	instrument, exists := r.instruments[pair]
+	return instrument, exists
+}
+
+// GetById returns the instrument orders with the OrderPair value are sent for.
+func (r *InstrumentRegistry) GetById(pair proto.OrderPair) (*models.InstrumentModel, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	instrument, exists := r.byId[pair]
+	return instrument, exists
+}
+
+// Name returns the registered name of the OrderPair value, falling back to the
+// order.proto name, so pairs unknown to the compiled enum keep their real name.
+func (r *InstrumentRegistry) Name(pair proto.OrderPair) string {
+	if instrument, exists := r.GetById(pair); exists {
+		return instrument.Pair
+	}
+	return pair.String()
+}
+
+// Pairs returns the names of the active pairs ordered by their OrderPair values.
+func (r *InstrumentRegistry) Pairs() []string {
+	return r.pairs(true)
+}
+
+// AllPairs returns the names of all registered pairs, active or not, ordered by their OrderPair values.
+func (r *InstrumentRegistry) AllPairs() []string {
+	return r.pairs(false)
+}
+
+func (r *InstrumentRegistry) pairs(onlyEnabled bool) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	instruments := make([]*models.InstrumentModel, 0, len(r.instruments))
+	for _, instrument := range r.instruments {
+		if onlyEnabled && !instrument.Enabled {
+			continue
+		}
+		instruments = append(instruments, instrument)
+	}
+	sort.Slice(instruments, func(i, j int) bool { return instruments[i].Id < instruments[j].Id })
+
+	pairs := make([]string, 0, len(instruments))
+	for _, instrument := range instruments {
+		pairs = append(pairs, instrument.Pair)
+	}
+	return pairs
+}
@@ -0,0 +1,66 @@
+package registry
+
+import (
+	"QuoteService/utils"
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRefreshKeepsStoredSteps(t *testing.T) {
+	tests := []struct {
+		name           string
+		instrumentJson string
+		wantPriceTick  utils.Step
+		wantVolumeStep utils.Step
+		wantPrecision  int
+	}{
+		{
+			name:           "changed tick and step are ignored",
+			instrumentJson: `{"baseCurrency":"USD","quoteCurrency":"EUR","priceTick":"0.001","volumeStep":"0.1","pricePrecision":5,"volumePrecision":2}`,
+			wantPriceTick:  utils.Step{Units: 1, Scale: 4},
+			wantVolumeStep: utils.Step{Units: 1, Scale: 2},
+			wantPrecision:  5,
+		},
+		{
+			name:           "other fields still change",
+			instrumentJson: `{"baseCurrency":"USD","quoteCurrency":"EUR","priceTick":"0.0001","volumeStep":"0.01","pricePrecision":6,"volumePrecision":2}`,
+			wantPriceTick:  utils.Step{Units: 1, Scale: 4},
+			wantVolumeStep: utils.Step{Units: 1, Scale: 2},
+			wantPrecision:  6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redisClient := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+			t.Cleanup(func() { redisClient.Close() })
+
+			instrumentRegistry, err := NewInstrumentRegistry(redisClient, "../config/instruments.json")
+			if err != nil {
+				t.Fatalf("NewInstrumentRegistry() error = %v", err)
+			}
+
+			if err := redisClient.HSet(context.Background(), instrumentsKey, "USD_EUR", tt.instrumentJson).Err(); err != nil {
+				t.Fatalf("HSet() error = %v", err)
+			}
+			// A restart reads the changed instrument too.
+			for _, refresh := range []func() error{instrumentRegistry.Refresh, func() error {
+				instrumentRegistry, err = NewInstrumentRegistry(redisClient, "../config/instruments.json")
+				return err
+			}} {
+				if err := refresh(); err != nil {
+					t.Fatalf("refresh error = %v", err)
+				}
+
+				instrument, _ := instrumentRegistry.Get("USD_EUR")
+				if instrument.PriceTick != tt.wantPriceTick || instrument.VolumeStep != tt.wantVolumeStep || instrument.PricePrecision != tt.wantPrecision {
+					t.Errorf("instrument = tick %s, step %s, price precision %d, want %s, %s, %d", instrument.PriceTick, instrument.VolumeStep,
+						instrument.PricePrecision, tt.wantPriceTick, tt.wantVolumeStep, tt.wantPrecision)
+				}
+			}
+		})
+	}
+}
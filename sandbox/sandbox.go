@@ -4,6 +4,8 @@ import (
 	"QuoteService/converters"
 	"QuoteService/proto"
 	"QuoteService/providers"
+	"QuoteService/registry"
+	"strconv"
 
 	logger "github.com/sirupsen/logrus"
 	googleProto "google.golang.org/protobuf/proto"
@@ -11,53 +13,88 @@ import (
 
 type Sandbox struct {
 	RabbitProvider *providers.RabbitProvider
+	Instruments    *registry.InstrumentRegistry
 }
 
 var (
 	quoteServiceExchangeName = "ex.QuoteService"
 
-	marketDepthEventRkName = "rk.MarketDepthEvent"
-	quotesEventRkName      = "rk.QuotesEvent"
+	marketDepthEventRkName     = "rk.MarketDepthEvent.#"
+	marketDepthDiffEventRkName = "rk.MarketDepthDiffEvent"
+	quotesEventRkName          = "rk.QuotesEvent"
 
-	MarketDepthEventListenerQueueName = "q.QuoteService.MarketDepthEvent.Listener"
-	quotesEventListenerQueueName      = "q.QuoteService.QuotesEvent.Listener"
+	MarketDepthEventListenerQueueName     = "q.QuoteService.MarketDepthEvent.Listener"
+	marketDepthDiffEventListenerQueueName = "q.QuoteService.MarketDepthDiffEvent.Listener"
+	quotesEventListenerQueueName          = "q.QuoteService.QuotesEvent.Listener"
 
-	unmarshalMarketDepthEventErrMsg = "Error while unmarshal MarketDepthEvent"
-	unmarshalQuotesEventErrMsg      = "Error while unmarshal QuotesEvent"
+	unmarshalMarketDepthEventErrMsg     = "Error while unmarshal MarketDepthEvent: %s"
+	unmarshalMarketDepthDiffEventErrMsg = "Error while unmarshal MarketDepthDiffEvent: %s"
+	unmarshalQuotesEventErrMsg          = "Error while unmarshal QuotesEvent: %s"
 
-	marketDepthEventContentMsg = "For pair: %s and direction: %s market depth is: %+v"
-	quotesEventContentMsg      = "For pair: %s last match was with price: %f and volume: %f"
+	marketDepthEventContentMsg     = "For pair: %s and direction: %s market depth is: %+v"
+	marketDepthDiffEventContentMsg = "For pair: %s with sequence: %d and direction: %s level with price: %s has volume: %s"
+	quotesEventContentMsg          = "For pair: %s last match was with price: %s and volume: %s"
 )
 
 func (s *Sandbox) RunSandbox() {
-	msgs, ch := s.RabbitProvider.GetQueueConsumer(quoteServiceExchangeName, marketDepthEventRkName, MarketDepthEventListenerQueueName)
-	go s.RabbitProvider.RunListener(msgs, ch, s.processMarkerDepthEvent)
-
-	msgs, ch = s.RabbitProvider.GetQueueConsumer(quoteServiceExchangeName, quotesEventRkName, quotesEventListenerQueueName)
-	s.RabbitProvider.RunListener(msgs, ch, s.processQuoteEvent)
+	go s.RabbitProvider.RunListener(quoteServiceExchangeName, marketDepthEventRkName, MarketDepthEventListenerQueueName, s.processMarkerDepthEvent)
+	go s.RabbitProvider.RunListener(quoteServiceExchangeName, marketDepthDiffEventRkName, marketDepthDiffEventListenerQueueName, s.processMarketDepthDiffEvent)
+	s.RabbitProvider.RunListener(quoteServiceExchangeName, quotesEventRkName, quotesEventListenerQueueName, s.processQuoteEvent)
 }
 
-func (s *Sandbox) processMarkerDepthEvent(bytesMarketDepthEvent []byte) {
+func (s *Sandbox) processMarkerDepthEvent(bytesMarketDepthEvent []byte) error {
 	var marketDepthEvent proto.MarketDepthEvent
 	if err := googleProto.Unmarshal(bytesMarketDepthEvent, &marketDepthEvent); err != nil {
-		logger.Error(unmarshalMarketDepthEventErrMsg)
-		return
+		return providers.PoisonMessageError(unmarshalMarketDepthEventErrMsg, err.Error())
 	}
 
 	for _, pairMarketDepth := range marketDepthEvent.MarketDepth {
 		pairMarketDepthModel := converters.ConvertPairMarketDepthToModel(pairMarketDepth)
 		logger.Debugf(marketDepthEventContentMsg, pairMarketDepthModel.OrderPair, pairMarketDepthModel.OrderDirection, pairMarketDepthModel.VolumeByPriceModels)
 	}
+
+	return nil
+}
+
+func (s *Sandbox) processMarketDepthDiffEvent(bytesMarketDepthDiffEvent []byte) error {
+	var marketDepthDiffEvent proto.MarketDepthDiffEvent
+	if err := googleProto.Unmarshal(bytesMarketDepthDiffEvent, &marketDepthDiffEvent); err != nil {
+		return providers.PoisonMessageError(unmarshalMarketDepthDiffEventErrMsg, err.Error())
+	}
+
+	for _, level := range marketDepthDiffEvent.Levels {
+		logger.Debugf(marketDepthDiffEventContentMsg, s.Instruments.Name(marketDepthDiffEvent.Pair), marketDepthDiffEvent.Sequence, level.Direction.String(),
+			s.formatPrice(marketDepthDiffEvent.Pair, level.Price), s.formatVolume(marketDepthDiffEvent.Pair, level.Volume))
+	}
+
+	return nil
 }
 
-func (s *Sandbox) processQuoteEvent(bytesQuoteEvent []byte) {
+func (s *Sandbox) processQuoteEvent(bytesQuoteEvent []byte) error {
 	var quotesEvent proto.QuotesEvent
 	if err := googleProto.Unmarshal(bytesQuoteEvent, &quotesEvent); err != nil {
-		logger.Error(unmarshalQuotesEventErrMsg)
-		return
+		return providers.PoisonMessageError(unmarshalQuotesEventErrMsg, err.Error())
 	}
 
 	for _, pairQuote := range quotesEvent.CurrentQuotes {
-		logger.Debugf(quotesEventContentMsg, pairQuote.Pair.String(), pairQuote.Price, pairQuote.Volume)
+		logger.Debugf(quotesEventContentMsg, s.Instruments.Name(pairQuote.Pair), s.formatPrice(pairQuote.Pair, pairQuote.Price), s.formatVolume(pairQuote.Pair, pairQuote.Volume))
+	}
+
+	return nil
+}
+
+func (s *Sandbox) formatPrice(pair proto.OrderPair, price float64) string {
+	instrument, exists := s.Instruments.GetById(pair)
+	if !exists {
+		return strconv.FormatFloat(price, 'f', -1, 64)
+	}
+	return instrument.FormatPrice(price)
+}
+
+func (s *Sandbox) formatVolume(pair proto.OrderPair, volume float64) string {
+	instrument, exists := s.Instruments.GetById(pair)
+	if !exists {
+		return strconv.FormatFloat(volume, 'f', -1, 64)
 	}
+	return instrument.FormatVolume(volume)
 }
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var invalidStepErrMsg = "invalid step: %s"
+
+// Step is a decimal increment such as a price tick or a volume step, kept as Units * 10^-Scale
+// (0.0005 is {Units: 5, Scale: 4}). Values counted in whole steps are exact integers, so they
+// can be summed and compared without the rounding errors of float64.
+type Step struct {
+	Units int64
+	Scale int
+}
+
+// ParseStep parses a positive decimal string such as "0.0001" or "5".
+func ParseStep(value string) (Step, error) {
+	integerPart, fractionPart, _ := strings.Cut(strings.TrimSpace(value), ".")
+	units, err := strconv.ParseInt(integerPart+fractionPart, 10, 64)
+	if err != nil || units <= 0 {
+		return Step{}, fmt.Errorf(invalidStepErrMsg, value)
+	}
+
+	return Step{Units: units, Scale: len(fractionPart)}, nil
+}
+
+// ToSteps rounds value to the nearest whole number of steps.
+func (s Step) ToSteps(value float64) int64 {
+	scaledUnits := int64(math.Round(value * math.Pow10(s.Scale)))
+	if scaledUnits < 0 {
+		return -((-scaledUnits + s.Units/2) / s.Units)
+	}
+	return (scaledUnits + s.Units/2) / s.Units
+}
+
+// ToExactSteps returns the number of steps in value and whether value is a whole number of them,
+// so inputs that ToSteps would silently round can be rejected.
+func (s Step) ToExactSteps(value float64) (int64, bool) {
+	// The shortest decimal form of value is the one it was written with, e.g. 0.29 and not 0.28999999999999998.
+	integerPart, fractionPart, _ := strings.Cut(strconv.FormatFloat(math.Abs(value), 'f', -1, 64), ".")
+	if len(fractionPart) > s.Scale {
+		return s.ToSteps(value), false
+	}
+
+	scaledUnits, err := strconv.ParseInt(integerPart+fractionPart+strings.Repeat("0", s.Scale-len(fractionPart)), 10, 64)
+	if err != nil || scaledUnits%s.Units != 0 {
+		return s.ToSteps(value), false
+	}
+	if value < 0 {
+		return -scaledUnits / s.Units, true
+	}
+	return scaledUnits / s.Units, true
+}
+
+// ToFloat converts a number of steps to the float64 closest to its exact decimal value.
+func (s Step) ToFloat(steps int64) float64 {
+	value, _ := strconv.ParseFloat(s.Format(steps), 64)
+	return value
+}
+
+// Format returns the exact decimal representation of a number of steps with Scale fraction digits.
+func (s Step) Format(steps int64) string {
+	units := steps * s.Units
+	sign := ""
+	if units < 0 {
+		sign, units = "-", -units
+	}
+
+	digits := strconv.FormatInt(units, 10)
+	if s.Scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= s.Scale {
+		digits = strings.Repeat("0", s.Scale-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-s.Scale] + "." + digits[len(digits)-s.Scale:]
+}
+
+// Half returns the step that is exactly half of s, used for mid prices between two ticks.
+func (s Step) Half() Step {
+	if s.Units%2 == 0 {
+		return Step{Units: s.Units / 2, Scale: s.Scale}
+	}
+	return Step{Units: s.Units * 5, Scale: s.Scale + 1}
+}
+
+func (s Step) String() string {
+	return s.Format(1)
+}
+
+// Times returns the step of the product of values counted in s and in other steps,
+// such as the quote volume of a price in ticks and a volume in steps.
+func (s Step) Times(other Step) Step {
+	return Step{Units: s.Units * other.Units, Scale: s.Scale + other.Scale}
+}
@@ -0,0 +1,111 @@
+package utils
+
+import "testing"
+
+func TestParseStep(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Step
+		wantErr bool
+	}{
+		{value: "0.0001", want: Step{Units: 1, Scale: 4}},
+		{value: "0.0005", want: Step{Units: 5, Scale: 4}},
+		{value: "0.01", want: Step{Units: 1, Scale: 2}},
+		{value: "0.10", want: Step{Units: 10, Scale: 2}},
+		{value: "1", want: Step{Units: 1, Scale: 0}},
+		{value: "25", want: Step{Units: 25, Scale: 0}},
+		{value: " 0.5 ", want: Step{Units: 5, Scale: 1}},
+		{value: "", wantErr: true},
+		{value: "0", wantErr: true},
+		{value: "0.000", wantErr: true},
+		{value: "-0.01", wantErr: true},
+		{value: "1e-4", wantErr: true},
+		{value: "0.0.1", wantErr: true},
+		{value: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseStep(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseStep(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseStep(%q) = %+v, want %+v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestStepToSteps(t *testing.T) {
+	tests := []struct {
+		step  Step
+		value float64
+		want  int64
+	}{
+		{step: Step{Units: 1, Scale: 2}, value: 0.29, want: 29},
+		{step: Step{Units: 1, Scale: 2}, value: 1.006, want: 101},
+		{step: Step{Units: 1, Scale: 2}, value: 0.004, want: 0},
+		{step: Step{Units: 1, Scale: 2}, value: -0.29, want: -29},
+		{step: Step{Units: 5, Scale: 4}, value: 1.0005, want: 2001},
+		{step: Step{Units: 5, Scale: 4}, value: 1.0007, want: 2001},
+		{step: Step{Units: 5, Scale: 4}, value: 1.0008, want: 2002},
+		{step: Step{Units: 1, Scale: 0}, value: 3, want: 3},
+		{step: Step{Units: 25, Scale: 0}, value: 110, want: 4},
+	}
+
+	for _, tt := range tests {
+		if got := tt.step.ToSteps(tt.value); got != tt.want {
+			t.Errorf("%s.ToSteps(%v) = %d, want %d", tt.step, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestStepToExactSteps(t *testing.T) {
+	tests := []struct {
+		step      Step
+		value     float64
+		want      int64
+		wantExact bool
+	}{
+		{step: Step{Units: 1, Scale: 2}, value: 0.29, want: 29, wantExact: true},
+		{step: Step{Units: 1, Scale: 2}, value: -0.29, want: -29, wantExact: true},
+		{step: Step{Units: 1, Scale: 2}, value: 12, want: 1200, wantExact: true},
+		{step: Step{Units: 1, Scale: 2}, value: 0, want: 0, wantExact: true},
+		{step: Step{Units: 1, Scale: 2}, value: 0.005, want: 1, wantExact: false},
+		{step: Step{Units: 1, Scale: 2}, value: 0.004, want: 0, wantExact: false},
+		{step: Step{Units: 5, Scale: 4}, value: 1.0005, want: 2001, wantExact: true},
+		{step: Step{Units: 5, Scale: 4}, value: 1.0007, want: 2001, wantExact: false},
+		{step: Step{Units: 25, Scale: 0}, value: 100, want: 4, wantExact: true},
+		{step: Step{Units: 25, Scale: 0}, value: 110, want: 4, wantExact: false},
+	}
+
+	for _, tt := range tests {
+		got, exact := tt.step.ToExactSteps(tt.value)
+		if got != tt.want || exact != tt.wantExact {
+			t.Errorf("%s.ToExactSteps(%v) = %d, %v, want %d, %v", tt.step, tt.value, got, exact, tt.want, tt.wantExact)
+		}
+	}
+}
+
+func TestStepFormat(t *testing.T) {
+	tests := []struct {
+		step  Step
+		steps int64
+		want  string
+	}{
+		{step: Step{Units: 1, Scale: 4}, steps: 10005, want: "1.0005"},
+		{step: Step{Units: 1, Scale: 4}, steps: 5, want: "0.0005"},
+		{step: Step{Units: 5, Scale: 4}, steps: 3, want: "0.0015"},
+		{step: Step{Units: 1, Scale: 2}, steps: 0, want: "0.00"},
+		{step: Step{Units: 1, Scale: 2}, steps: -29, want: "-0.29"},
+		{step: Step{Units: 1, Scale: 2}, steps: 1200, want: "12.00"},
+		{step: Step{Units: 1, Scale: 0}, steps: 7, want: "7"},
+		{step: Step{Units: 25, Scale: 0}, steps: -4, want: "-100"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.step.Format(tt.steps); got != tt.want {
+			t.Errorf("%s.Format(%d) = %q, want %q", tt.step, tt.steps, got, tt.want)
+		}
+	}
+}